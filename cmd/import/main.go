@@ -85,6 +85,32 @@ var tasks = []struct {
 		keyExtractor("industries"),
 		"Industries",
 	},
+	{
+		"https://raw.githubusercontent.com/dariusk/corpora/master/data/corporations/fortune500.json",
+		keyExtractor("companies"),
+		"Fortune500",
+	},
+	{
+		"https://raw.githubusercontent.com/dariusk/corpora/master/data/corporations/nasdaq.json",
+		func(body io.ReadCloser) []string {
+			var jsonData struct {
+				Corporations []struct {
+					Name string `json:"name"`
+				} `json:"corporations"`
+			}
+			if err := json.NewDecoder(body).Decode(&jsonData); err != nil {
+				log.Fatal(err)
+			}
+
+			data := make([]string, len(jsonData.Corporations))
+			for i, corporation := range jsonData.Corporations {
+				data[i] = corporation.Name
+			}
+
+			return data
+		},
+		"Nasdaq",
+	},
 	{
 		"https://raw.githubusercontent.com/dariusk/corpora/master/data/humans/occupations.json",
 		keyExtractor("occupations"),
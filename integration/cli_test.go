@@ -94,6 +94,24 @@ func TestCLI(t *testing.T) {
 			"unknown-format.golden",
 			true,
 		},
+		{
+			"accesslog format with other generators",
+			[]string{"-f=accesslog", "name", "email"},
+			"accesslog-format-with-generators.golden",
+			true,
+		},
+		{
+			"accesslog format with header",
+			[]string{"-f=accesslog", "-H"},
+			"accesslog-format-with-header.golden",
+			true,
+		},
+		{
+			"unknown locale",
+			[]string{"--locale=xx_XX", "name"},
+			"unknown-locale.golden",
+			true,
+		},
 	}
 
 	for _, tt := range tests {
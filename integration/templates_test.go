@@ -2,8 +2,11 @@ package integration
 
 import (
 	"fmt"
+	"io"
+	"os"
 	"os/exec"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/lucapette/fakedata/testutil"
@@ -19,6 +22,12 @@ var templateTests = []struct {
 	{"loop-with-index.tmpl", "loop-with-index.golden", false},
 	{"broken.tmpl", "broken-template.golden", true},
 	{"unknown-function.tmpl", "unknown-function.golden", true},
+	{"constraints.tmpl", "constraints.golden", false},
+	{"invalid-constraint.tmpl", "invalid-constraint.golden", true},
+	{"gen.tmpl", "gen.golden", false},
+	{"context.tmpl", "context.golden", false},
+	{"header-footer.tmpl", "header-footer.golden", false},
+	{"gen-unknown-generator.tmpl", "gen-unknown-generator.golden", true},
 }
 
 func TestTemplatesWithCLIArgs(t *testing.T) {
@@ -70,3 +79,97 @@ func TestTemplatesWithPipe(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateIncludes(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		golden  string
+		wantErr bool
+	}{
+		{"include", []string{"-l2", "--template", "testutil/fixtures/include.tmpl"}, "include.golden", false},
+		{"nested includes", []string{"-l2", "--template", "testutil/fixtures/partials/users.tmpl"}, "template-dir.golden", false},
+		{"missing include", []string{"--template", "testutil/fixtures/include-missing.tmpl"}, "include-missing.golden", true},
+		{"template dir", []string{"-l2", "--template-dir", "testutil/fixtures/partials", "--template", "users.tmpl"}, "template-dir.golden", false},
+		{"template dir without template", []string{"--template-dir", "testutil/fixtures/partials"}, "template-dir-without-template.golden", true},
+		{"unknown template in template dir", []string{"--template-dir", "testutil/fixtures/partials", "--template", "orders.tmpl"}, "template-dir-unknown-template.golden", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			output, err := runBinary(tt.args...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("%s\nexpected (err != nil) to be %v, but got %v. err: %v", output, tt.wantErr, err != nil, err)
+			}
+
+			golden := testutil.NewGoldenFile(t, tt.golden)
+			actual := string(output)
+			if *update {
+				golden.Write(actual)
+			}
+
+			expected := golden.Load()
+
+			if !reflect.DeepEqual(actual, expected) {
+				t.Fatalf("diff: %v", testutil.Diff(expected, actual))
+			}
+		})
+	}
+}
+
+func TestTemplateDirWithPipe(t *testing.T) {
+	cmd := exec.Command(binaryPath, "-l2", "--template-dir", "testutil/fixtures/partials")
+	cmd.Stdin = strings.NewReader(`{{ .Index }}: {{ template "person.tmpl" . }}` + "\n")
+	cmd.Env = append(os.Environ(), "GOCOVERDIR=.coverdata")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		t.Fatalf("%s\nunexpected error: %v", output, err)
+	}
+
+	expected := testutil.NewGoldenFile(t, "template-dir.golden").Load()
+	if actual := string(output); actual != expected {
+		t.Fatalf("diff: %v", testutil.Diff(expected, actual))
+	}
+}
+
+func TestTemplateFooterOnInterrupt(t *testing.T) {
+	cmd := exec.Command(binaryPath, "--stream", "--template", "testutil/fixtures/header-footer.tmpl")
+	cmd.Env = append(os.Environ(), "GOCOVERDIR=.coverdata")
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cmd.Start(); err != nil {
+		t.Fatal(err)
+	}
+
+	// rows are flushed in chunks, so the first one means the stream started
+	first := make([]byte, 4096)
+	n, err := stdout.Read(first)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cmd.Process.Signal(os.Interrupt); err != nil {
+		t.Fatal(err)
+	}
+
+	rest, err := io.ReadAll(stdout)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if err := cmd.Wait(); err != nil {
+		t.Fatal(err)
+	}
+
+	output := string(append(first[:n], rest...))
+	if !strings.HasPrefix(output, "<table>\n") || !strings.HasSuffix(output, "</table>\n<!-- 0 rows -->\n") {
+		t.Errorf("expected the output to start with the header and end with the footer, but got %s", output)
+	}
+
+	if footers := strings.Count(output, "</table>"); footers != 1 {
+		t.Errorf("expected one footer, but got %d", footers)
+	}
+}
@@ -3,9 +3,13 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
 
 	"github.com/lucapette/fakedata/pkg/fakedata"
 	flag "github.com/spf13/pflag"
@@ -39,44 +43,78 @@ func isPipe() bool {
 	return (stat.Mode() & os.ModeCharDevice) == 0
 }
 
-func findTemplate(path string) string {
+// findTemplate returns the template to execute, nil if there is none. With a
+// template dir, path is the name of a template in dir
+func findTemplate(path, dir string) (*fakedata.Template, error) {
+	if path != "" && dir != "" {
+		return fakedata.ParseTemplateDir(dir, path)
+	}
+
 	if path != "" {
 		tp, err := os.ReadFile(path)
 		if err != nil {
-			fmt.Printf("unable to read input: %s", err)
-			os.Exit(1)
+			return nil, fmt.Errorf("unable to read input: %s", err)
 		}
 
-		return string(tp)
+		return fakedata.ParseTemplate(string(tp), filepath.Dir(path))
 	}
 
 	if isPipe() {
 		tp, err := io.ReadAll(os.Stdin)
 		if err != nil {
-			fmt.Printf("unable to read input: %s", err)
-			os.Exit(1)
+			return nil, fmt.Errorf("unable to read input: %s", err)
+		}
+
+		if len(tp) > 0 {
+			if dir == "" {
+				dir = "."
+			}
+
+			return fakedata.ParseTemplate(string(tp), dir)
 		}
+	}
+
+	if dir != "" {
+		return nil, fmt.Errorf("--template-dir needs the name of a template, pass it with --template")
+	}
+
+	return nil, nil
+}
+
+// accessLogArgs returns the generator of the accesslog format. Log lines have
+// no header and no other columns, so it accepts only log.apache (the default)
+// or log.nginx
+func accessLogArgs(args []string, header bool) ([]string, error) {
+	if header {
+		return nil, fmt.Errorf("the accesslog format does not support --header")
+	}
 
-		return string(tp)
+	switch {
+	case len(args) == 0:
+		return []string{"log.apache"}, nil
+	case len(args) == 1 && (args[0] == "log.apache" || args[0] == "log.nginx"):
+		return args, nil
 	}
 
-	return ""
+	return nil, fmt.Errorf("the accesslog format accepts either log.apache or log.nginx, got: %s", strings.Join(args, " "))
 }
 
 func main() {
 	var (
 		completionFlag  = flag.StringP("completion", "C", "", "print shell completion function, pass shell name as argument (\"bash\", \"zsh\" or \"fish\")")
 		constraintsFlag = flag.BoolP("generators-with-constraints", "c", false, "lists available generators with constraints")
-		formatFlag      = flag.StringP("format", "f", "column", "generates rows in f format. Available formats: column|ndjson|sql")
+		formatFlag      = flag.StringP("format", "f", "column", "generates rows in f format. Available formats: accesslog|column|ndjson|sql")
 		generatorFlag   = flag.StringP("generator", "g", "", "show help for a specific generator")
 		generatorsFlag  = flag.BoolP("generators", "G", false, "lists available generators")
 		headerFlag      = flag.BoolP("header", "H", false, "adds headers row")
 		helpFlag        = flag.BoolP("help", "h", false, "shows help")
 		limitFlag       = flag.IntP("limit", "l", 10, "limits rows up to n")
+		localeFlag      = flag.StringP("locale", "L", "", "uses locale-specific data for names, cities, streets and phone numbers (example: de_DE)")
 		separatorFlag   = flag.StringP("separator", "s", " ", "specifies separator for the column format")
 		streamFlag      = flag.BoolP("stream", "S", false, "streams rows till the end of time")
 		tableFlag       = flag.StringP("table", "t", "TABLE", "table name of the sql format")
 		templateFlag    = flag.StringP("template", "T", "", "Use template as input")
+		templateDirFlag = flag.String("template-dir", "", "loads the *.tmpl files in dir. --template selects the one to use by name")
 		versionFlag     = flag.BoolP("version", "v", false, "shows version information")
 	)
 
@@ -105,7 +143,14 @@ func main() {
 		os.Exit(0)
 	}
 
-	generators := fakedata.NewGenerators()
+	locale, err := fakedata.FindLocale(*localeFlag)
+	if err != nil {
+		fmt.Printf("%v\n\n", err)
+		flag.Usage()
+		os.Exit(1)
+	}
+
+	generators := fakedata.NewLocalizedGenerators(locale)
 
 	if *generatorsFlag {
 		fmt.Print(generatorsHelp(generators.Visible()))
@@ -133,12 +178,26 @@ func main() {
 	}
 
 	if *constraintsFlag {
-		fmt.Print(generatorsHelp(generators.WithConstraints()))
+		fmt.Print(generatorsHelp(generators.Visible().WithConstraints()))
 		os.Exit(0)
 	}
 
-	if tmpl := findTemplate(*templateFlag); tmpl != "" {
-		if err := fakedata.ExecuteTemplate(tmpl, *limitFlag, *streamFlag); err != nil {
+	tmpl, err := findTemplate(*templateFlag, *templateDirFlag)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(1)
+	}
+
+	if tmpl != nil {
+		ctx := context.Background()
+		if *streamFlag {
+			var stop context.CancelFunc
+			ctx, stop = signal.NotifyContext(ctx, os.Interrupt)
+			defer stop()
+		}
+
+		opts := fakedata.TemplateOptions{Limit: *limitFlag, Stream: *streamFlag, Locale: locale}
+		if err := tmpl.Execute(ctx, os.Stdout, opts); err != nil {
 			fmt.Println(err)
 			os.Exit(1)
 		}
@@ -146,12 +205,22 @@ func main() {
 		return
 	}
 
-	if len(flag.Args()) == 0 {
+	args := flag.Args()
+	if *formatFlag == "accesslog" {
+		args, err = accessLogArgs(args, *headerFlag)
+		if err != nil {
+			fmt.Printf("%v\n\n", err)
+			flag.Usage()
+			os.Exit(1)
+		}
+	}
+
+	if len(args) == 0 {
 		flag.Usage()
 		os.Exit(0)
 	}
 
-	columns, err := fakedata.NewColumns(flag.Args())
+	columns, err := fakedata.NewLocalizedColumns(args, locale)
 	if err != nil {
 		fmt.Printf("%v\n\n", err)
 		flag.Usage()
@@ -163,6 +232,8 @@ func main() {
 	switch *formatFlag {
 	case "column":
 		formatter = fakedata.NewColumnFormatter(*separatorFlag)
+	case "accesslog":
+		formatter = fakedata.NewColumnFormatter(" ")
 	case "sql":
 		formatter = fakedata.NewSQLFormatter(*tableFlag)
 	case "ndjson":
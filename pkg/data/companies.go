@@ -0,0 +1,26 @@
+package data
+
+// source: curated by hand. cmd/import reads company names (Fortune500 and
+// Nasdaq) from dariusk/corpora, which has no lists of legal entity suffixes,
+// retail departments or job title parts, so the importer doesn't generate this
+// file
+
+// CompanySuffixes is an array of legal entity suffixes
+var CompanySuffixes = []string{"Inc", "LLC", "Ltd", "Corp", "Co", "Group", "Holdings", "Partners", "PLC", "LLP", "GmbH", "AG", "SA", "SRL", "BV", "Pty Ltd"}
+
+// ProductCategories is an array of retail departments
+var ProductCategories = []string{"Automotive", "Baby", "Beauty", "Books", "Clothing", "Computers", "Electronics", "Games", "Garden", "Grocery", "Health", "Home", "Industrial", "Jewelry", "Kids", "Movies", "Music", "Outdoors", "Shoes", "Sports", "Tools", "Toys"}
+
+// ProductNamePrefixes and ProductNameSuffixes are fragments of made-up product
+// names
+var (
+	ProductNamePrefixes = []string{"Alpha", "Bam", "Bio", "Cof", "Dom", "Duo", "Flex", "Free", "Hat", "Hot", "Inch", "Job", "Kon", "Lot", "Mat", "Nam", "Ozer", "Quad", "Ran", "Rank", "Ron", "Run", "Sil", "Silver", "Solo", "Sonic", "Span", "Stat", "Stim", "Stron", "Sub", "Tamp", "Ton", "Tough", "Trans", "Tres", "Tri", "Viva", "Vol", "Zath", "Zoo"}
+	ProductNameSuffixes = []string{"dex", "dax", "ex", "fax", "fix", "flex", "hold", "home", "ing", "is", "it", "ity", "kix", "lab", "lam", "light", "log", "lux", "nix", "quadfax", "san", "tam", "tax", "tom", "tone", "top", "trax", "warm", "zap", "zim"}
+)
+
+// JobLevels, JobAreas and JobRoles are the parts of job titles
+var (
+	JobLevels = []string{"Associate", "Chief", "Junior", "Lead", "Principal", "Senior", "Staff"}
+	JobAreas  = []string{"Accounting", "Brand", "Customer Success", "Data", "Design", "Engineering", "Finance", "Human Resources", "Infrastructure", "Legal", "Logistics", "Marketing", "Operations", "Product", "Quality", "Research", "Sales", "Security", "Software", "Support"}
+	JobRoles  = []string{"Administrator", "Analyst", "Architect", "Consultant", "Coordinator", "Designer", "Developer", "Director", "Engineer", "Manager", "Officer", "Planner", "Scientist", "Specialist", "Strategist", "Technician"}
+)
@@ -0,0 +1,168 @@
+package data
+
+// A Currency is an ISO 4217 currency. MinorUnits is the number of decimals of
+// its amounts
+type Currency struct {
+	Code       string
+	Symbol     string
+	MinorUnits int
+}
+
+// Currencies is an array of active ISO 4217 currencies
+var Currencies = []Currency{
+	{"AED", "د.إ", 2},
+	{"AFN", "؋", 2},
+	{"ALL", "L", 2},
+	{"AMD", "֏", 2},
+	{"ANG", "ƒ", 2},
+	{"AOA", "Kz", 2},
+	{"ARS", "$", 2},
+	{"AUD", "$", 2},
+	{"AWG", "ƒ", 2},
+	{"AZN", "₼", 2},
+	{"BAM", "KM", 2},
+	{"BBD", "$", 2},
+	{"BDT", "৳", 2},
+	{"BGN", "лв", 2},
+	{"BHD", ".د.ب", 3},
+	{"BIF", "FBu", 0},
+	{"BMD", "$", 2},
+	{"BND", "$", 2},
+	{"BOB", "Bs.", 2},
+	{"BRL", "R$", 2},
+	{"BSD", "$", 2},
+	{"BTN", "Nu.", 2},
+	{"BWP", "P", 2},
+	{"BYN", "Br", 2},
+	{"BZD", "$", 2},
+	{"CAD", "$", 2},
+	{"CDF", "FC", 2},
+	{"CHF", "CHF", 2},
+	{"CLP", "$", 0},
+	{"CNY", "¥", 2},
+	{"COP", "$", 2},
+	{"CRC", "₡", 2},
+	{"CUP", "$", 2},
+	{"CVE", "$", 2},
+	{"CZK", "Kč", 2},
+	{"DJF", "Fdj", 0},
+	{"DKK", "kr", 2},
+	{"DOP", "$", 2},
+	{"DZD", "د.ج", 2},
+	{"EGP", "£", 2},
+	{"ERN", "Nfk", 2},
+	{"ETB", "Br", 2},
+	{"EUR", "€", 2},
+	{"FJD", "$", 2},
+	{"FKP", "£", 2},
+	{"GBP", "£", 2},
+	{"GEL", "₾", 2},
+	{"GHS", "₵", 2},
+	{"GIP", "£", 2},
+	{"GMD", "D", 2},
+	{"GNF", "FG", 0},
+	{"GTQ", "Q", 2},
+	{"GYD", "$", 2},
+	{"HKD", "$", 2},
+	{"HNL", "L", 2},
+	{"HTG", "G", 2},
+	{"HUF", "Ft", 2},
+	{"IDR", "Rp", 2},
+	{"ILS", "₪", 2},
+	{"INR", "₹", 2},
+	{"IQD", "ع.د", 3},
+	{"IRR", "﷼", 2},
+	{"ISK", "kr", 0},
+	{"JMD", "$", 2},
+	{"JOD", "د.ا", 3},
+	{"JPY", "¥", 0},
+	{"KES", "KSh", 2},
+	{"KGS", "с", 2},
+	{"KHR", "៛", 2},
+	{"KMF", "CF", 0},
+	{"KPW", "₩", 2},
+	{"KRW", "₩", 0},
+	{"KWD", "د.ك", 3},
+	{"KYD", "$", 2},
+	{"KZT", "₸", 2},
+	{"LAK", "₭", 2},
+	{"LBP", "ل.ل", 2},
+	{"LKR", "Rs", 2},
+	{"LRD", "$", 2},
+	{"LSL", "L", 2},
+	{"LYD", "ل.د", 3},
+	{"MAD", "د.م.", 2},
+	{"MDL", "L", 2},
+	{"MGA", "Ar", 2},
+	{"MKD", "ден", 2},
+	{"MMK", "K", 2},
+	{"MNT", "₮", 2},
+	{"MOP", "MOP$", 2},
+	{"MRU", "UM", 2},
+	{"MUR", "₨", 2},
+	{"MVR", "Rf", 2},
+	{"MWK", "MK", 2},
+	{"MXN", "$", 2},
+	{"MYR", "RM", 2},
+	{"MZN", "MT", 2},
+	{"NAD", "$", 2},
+	{"NGN", "₦", 2},
+	{"NIO", "C$", 2},
+	{"NOK", "kr", 2},
+	{"NPR", "₨", 2},
+	{"NZD", "$", 2},
+	{"OMR", "ر.ع.", 3},
+	{"PAB", "B/.", 2},
+	{"PEN", "S/", 2},
+	{"PGK", "K", 2},
+	{"PHP", "₱", 2},
+	{"PKR", "₨", 2},
+	{"PLN", "zł", 2},
+	{"PYG", "₲", 0},
+	{"QAR", "ر.ق", 2},
+	{"RON", "lei", 2},
+	{"RSD", "дин.", 2},
+	{"RUB", "₽", 2},
+	{"RWF", "FRw", 0},
+	{"SAR", "ر.س", 2},
+	{"SBD", "$", 2},
+	{"SCR", "₨", 2},
+	{"SDG", "ج.س.", 2},
+	{"SEK", "kr", 2},
+	{"SGD", "$", 2},
+	{"SHP", "£", 2},
+	{"SLE", "Le", 2},
+	{"SOS", "Sh", 2},
+	{"SRD", "$", 2},
+	{"SSP", "£", 2},
+	{"STN", "Db", 2},
+	{"SVC", "₡", 2},
+	{"SYP", "£", 2},
+	{"SZL", "L", 2},
+	{"THB", "฿", 2},
+	{"TJS", "SM", 2},
+	{"TMT", "m", 2},
+	{"TND", "د.ت", 3},
+	{"TOP", "T$", 2},
+	{"TRY", "₺", 2},
+	{"TTD", "$", 2},
+	{"TWD", "$", 2},
+	{"TZS", "TSh", 2},
+	{"UAH", "₴", 2},
+	{"UGX", "USh", 0},
+	{"USD", "$", 2},
+	{"UYU", "$", 2},
+	{"UZS", "soʻm", 2},
+	{"VES", "Bs.S", 2},
+	{"VND", "₫", 0},
+	{"VUV", "VT", 0},
+	{"WST", "T", 2},
+	{"XAF", "FCFA", 0},
+	{"XCD", "$", 2},
+	{"XOF", "CFA", 0},
+	{"XPF", "₣", 0},
+	{"YER", "﷼", 2},
+	{"ZAR", "R", 2},
+	{"ZMW", "ZK", 2},
+	{"ZWL", "$", 2},
+}
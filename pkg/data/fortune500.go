@@ -0,0 +1,3 @@
+package data
+
+var Fortune500 = []string{"Wal-Mart Stores", "Exxon Mobil", "Chevron", "Berkshire Hathaway", "Apple", "Phillips 66", "General Motors", "Ford Motor", "General Electric", "Valero Energy", "AT&T", "CVS Caremark", "Fannie Mae", "UnitedHealth Group", "McKesson", "Verizon Communications", "Hewlett-Packard", "J.P. Morgan Chase & Co.", "Costco Wholesale", "Express Scripts Holding", "Bank of America Corp.", "Cardinal Health", "International Business Machines", "Kroger", "Marathon Petroleum", "Citigroup", "Archer Daniels Midland", "AmerisourceBergen", "Wells Fargo", "Boeing", "Procter & Gamble", "Freddie Mac", "Home Depot", "Microsoft", "Amazon.com", "Target", "Walgreen", "American International Group", "Johnson & Johnson", "State Farm Insurance Cos."}
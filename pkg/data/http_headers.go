@@ -0,0 +1,20 @@
+package data
+
+// HTTPHeaders is a map of common HTTP header names to some of their values
+var HTTPHeaders = map[string][]string{
+	"Accept":                    {"*/*", "application/json", "text/html,application/xhtml+xml,application/xml;q=0.9,*/*;q=0.8", "image/avif,image/webp,*/*"},
+	"Accept-Encoding":           {"gzip, deflate, br", "gzip", "identity", "br"},
+	"Accept-Language":           {"en-US,en;q=0.9", "en-GB,en;q=0.8", "de-DE,de;q=0.9,en;q=0.8", "fr-FR,fr;q=0.9", "it-IT,it;q=0.9,en;q=0.7", "ja-JP,ja;q=0.9", "pt-BR,pt;q=0.9"},
+	"Cache-Control":             {"no-cache", "no-store", "max-age=0", "max-age=3600", "public, max-age=31536000, immutable", "private, max-age=600"},
+	"Connection":                {"keep-alive", "close", "upgrade"},
+	"Content-Encoding":          {"gzip", "br", "deflate"},
+	"Content-Type":              {"application/json", "application/json; charset=utf-8", "text/html; charset=utf-8", "text/plain; charset=utf-8", "application/x-www-form-urlencoded", "multipart/form-data"},
+	"DNT":                       {"0", "1"},
+	"Pragma":                    {"no-cache"},
+	"Server":                    {"nginx", "nginx/1.25.3", "Apache", "Apache/2.4.58 (Unix)", "cloudflare", "Microsoft-IIS/10.0", "gunicorn"},
+	"Upgrade-Insecure-Requests": {"1"},
+	"Vary":                      {"Accept-Encoding", "Origin", "Accept, Accept-Encoding", "Cookie"},
+	"X-Content-Type-Options":    {"nosniff"},
+	"X-Forwarded-Proto":         {"http", "https"},
+	"X-Frame-Options":           {"DENY", "SAMEORIGIN"},
+}
@@ -0,0 +1,41 @@
+package data
+
+// An HTTPStatus is an HTTP status code along with how often it shows up in
+// real traffic, relative to the other codes
+type HTTPStatus struct {
+	Code   string
+	Weight int
+}
+
+// HTTPStatuses is an array of the common HTTP status codes
+var HTTPStatuses = []HTTPStatus{
+	{"100", 1},
+	{"101", 1},
+	{"200", 700},
+	{"201", 20},
+	{"202", 5},
+	{"204", 10},
+	{"206", 5},
+	{"301", 15},
+	{"302", 30},
+	{"303", 3},
+	{"304", 80},
+	{"307", 3},
+	{"308", 2},
+	{"400", 15},
+	{"401", 10},
+	{"403", 10},
+	{"404", 70},
+	{"405", 2},
+	{"409", 2},
+	{"410", 1},
+	{"413", 1},
+	{"415", 1},
+	{"422", 3},
+	{"429", 3},
+	{"500", 10},
+	{"501", 1},
+	{"502", 5},
+	{"503", 5},
+	{"504", 3},
+}
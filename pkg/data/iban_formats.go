@@ -0,0 +1,38 @@
+package data
+
+// IBANFormats is a map of 2-digit country codes to the format of their basic
+// bank account numbers. # is a digit and A an uppercase letter
+var IBANFormats = map[string]string{
+	"AT": "################",
+	"BE": "############",
+	"BG": "AAAA##############",
+	"CH": "#################",
+	"CY": "########################",
+	"CZ": "####################",
+	"DE": "##################",
+	"DK": "##############",
+	"EE": "################",
+	"ES": "####################",
+	"FI": "##############",
+	"FR": "#######################",
+	"GB": "AAAA##############",
+	"GR": "#######################",
+	"HR": "#################",
+	"HU": "########################",
+	"IE": "AAAA##############",
+	"IS": "######################",
+	"IT": "A######################",
+	"LI": "#################",
+	"LT": "################",
+	"LU": "################",
+	"LV": "AAAA#############",
+	"MT": "AAAA#######################",
+	"NL": "AAAA##########",
+	"NO": "###########",
+	"PL": "########################",
+	"PT": "#####################",
+	"RO": "AAAA################",
+	"SE": "####################",
+	"SI": "###############",
+	"SK": "####################",
+}
@@ -0,0 +1,149 @@
+package data
+
+var deDE = Locale{
+	Country: "DE",
+	Firstnames: []string{
+		"Alexander",
+		"Andreas",
+		"Anna",
+		"Benjamin",
+		"Birgit",
+		"Christian",
+		"Claudia",
+		"Daniel",
+		"Elias",
+		"Emma",
+		"Felix",
+		"Finn",
+		"Frank",
+		"Gabriele",
+		"Hannah",
+		"Jan",
+		"Jonas",
+		"Julia",
+		"Jürgen",
+		"Katharina",
+		"Klaus",
+		"Lea",
+		"Leon",
+		"Lukas",
+		"Maria",
+		"Marie",
+		"Markus",
+		"Michael",
+		"Mia",
+		"Monika",
+		"Noah",
+		"Paul",
+		"Petra",
+		"Sabine",
+		"Sandra",
+		"Sophie",
+		"Stefan",
+		"Thomas",
+		"Ursula",
+		"Wolfgang",
+	},
+	Lastnames: []string{
+		"Bauer",
+		"Becker",
+		"Braun",
+		"Fischer",
+		"Frank",
+		"Friedrich",
+		"Günther",
+		"Hartmann",
+		"Hoffmann",
+		"Hofmann",
+		"Keller",
+		"Klein",
+		"Koch",
+		"König",
+		"Krause",
+		"Krüger",
+		"Lange",
+		"Lehmann",
+		"Meier",
+		"Meyer",
+		"Müller",
+		"Neumann",
+		"Richter",
+		"Schmidt",
+		"Schmitt",
+		"Schneider",
+		"Schröder",
+		"Schulz",
+		"Schwarz",
+		"Schäfer",
+		"Wagner",
+		"Walter",
+		"Weber",
+		"Werner",
+		"Wolf",
+		"Zimmermann",
+	},
+	Cities: []string{
+		"Berlin",
+		"Hamburg",
+		"München",
+		"Köln",
+		"Frankfurt am Main",
+		"Stuttgart",
+		"Düsseldorf",
+		"Leipzig",
+		"Dortmund",
+		"Essen",
+		"Bremen",
+		"Dresden",
+		"Hannover",
+		"Nürnberg",
+		"Duisburg",
+		"Bochum",
+		"Wuppertal",
+		"Bielefeld",
+		"Bonn",
+		"Münster",
+		"Mannheim",
+		"Karlsruhe",
+		"Augsburg",
+		"Wiesbaden",
+		"Mönchengladbach",
+		"Gelsenkirchen",
+		"Aachen",
+		"Braunschweig",
+		"Kiel",
+		"Chemnitz",
+	},
+	Streets: []string{
+		"Hauptstraße",
+		"Schulstraße",
+		"Gartenstraße",
+		"Bahnhofstraße",
+		"Dorfstraße",
+		"Bergstraße",
+		"Birkenweg",
+		"Lindenstraße",
+		"Kirchstraße",
+		"Waldstraße",
+		"Ringstraße",
+		"Schillerstraße",
+		"Goethestraße",
+		"Wiesenweg",
+		"Mühlenweg",
+		"Am Sportplatz",
+		"Friedhofstraße",
+		"Feldstraße",
+		"Buchenweg",
+		"Mozartstraße",
+		"Rosenstraße",
+		"Blumenstraße",
+		"Eichenweg",
+		"Industriestraße",
+		"Poststraße",
+		"Am Markt",
+		"Kastanienallee",
+		"Lessingstraße",
+		"Beethovenstraße",
+		"Parkstraße",
+	},
+}
@@ -0,0 +1,141 @@
+package data
+
+var frFR = Locale{
+	Country: "FR",
+	Firstnames: []string{
+		"Adèle",
+		"Alice",
+		"Amélie",
+		"Antoine",
+		"Arthur",
+		"Camille",
+		"Chloé",
+		"Claire",
+		"Clément",
+		"Élodie",
+		"Émilie",
+		"Étienne",
+		"François",
+		"Gabriel",
+		"Hugo",
+		"Inès",
+		"Jacques",
+		"Jean",
+		"Jules",
+		"Juliette",
+		"Léa",
+		"Louis",
+		"Louise",
+		"Lucas",
+		"Manon",
+		"Marie",
+		"Mathilde",
+		"Nathalie",
+		"Nicolas",
+		"Paul",
+		"Philippe",
+		"Pierre",
+		"Raphaël",
+		"Sophie",
+		"Théo",
+		"Thomas",
+	},
+	Lastnames: []string{
+		"Bernard",
+		"Bertrand",
+		"Blanc",
+		"Bonnet",
+		"Chevalier",
+		"David",
+		"Dubois",
+		"Dupont",
+		"Durand",
+		"Faure",
+		"Fontaine",
+		"Fournier",
+		"Garcia",
+		"Gauthier",
+		"Girard",
+		"Lambert",
+		"Laurent",
+		"Lefebvre",
+		"Lefèvre",
+		"Leroy",
+		"Martin",
+		"Mercier",
+		"Michel",
+		"Moreau",
+		"Morel",
+		"Petit",
+		"Richard",
+		"Robert",
+		"Roux",
+		"Simon",
+		"Thomas",
+		"Vincent",
+	},
+	Cities: []string{
+		"Paris",
+		"Marseille",
+		"Lyon",
+		"Toulouse",
+		"Nice",
+		"Nantes",
+		"Montpellier",
+		"Strasbourg",
+		"Bordeaux",
+		"Lille",
+		"Rennes",
+		"Reims",
+		"Toulon",
+		"Saint-Étienne",
+		"Le Havre",
+		"Grenoble",
+		"Dijon",
+		"Angers",
+		"Nîmes",
+		"Villeurbanne",
+		"Clermont-Ferrand",
+		"Le Mans",
+		"Aix-en-Provence",
+		"Brest",
+		"Tours",
+		"Amiens",
+		"Limoges",
+		"Annecy",
+		"Perpignan",
+		"Metz",
+	},
+	Streets: []string{
+		"Rue de la Paix",
+		"Rue de l'Église",
+		"Rue du Moulin",
+		"Rue de la Mairie",
+		"Rue du Château",
+		"Rue des Écoles",
+		"Rue de la Gare",
+		"Rue Principale",
+		"Grande Rue",
+		"Rue Victor Hugo",
+		"Rue Jean Jaurès",
+		"Rue Pasteur",
+		"Rue de la République",
+		"Avenue Foch",
+		"Avenue des Champs-Élysées",
+		"Boulevard Saint-Germain",
+		"Boulevard Haussmann",
+		"Place de la Liberté",
+		"Rue du Général de Gaulle",
+		"Rue des Lilas",
+		"Rue des Jardins",
+		"Chemin des Vignes",
+		"Impasse des Roses",
+		"Allée des Tilleuls",
+		"Rue Voltaire",
+		"Rue Émile Zola",
+		"Quai de la Tournelle",
+		"Rue du Faubourg Saint-Honoré",
+		"Rue de Rivoli",
+		"Rue Nationale",
+	},
+}
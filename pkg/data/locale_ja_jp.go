@@ -0,0 +1,129 @@
+package data
+
+var jaJP = Locale{
+	Country:         "JP",
+	FamilyNameFirst: true,
+	Firstnames: []string{
+		"翔太",
+		"蓮",
+		"大翔",
+		"陽翔",
+		"悠真",
+		"湊",
+		"蒼",
+		"樹",
+		"大輔",
+		"健太",
+		"拓也",
+		"直樹",
+		"浩",
+		"誠",
+		"翼",
+		"陽菜",
+		"結衣",
+		"葵",
+		"凛",
+		"芽依",
+		"さくら",
+		"美咲",
+		"愛",
+		"彩",
+		"優子",
+		"恵子",
+		"由美",
+		"真由美",
+		"明美",
+		"花子",
+	},
+	Lastnames: []string{
+		"佐藤",
+		"鈴木",
+		"高橋",
+		"田中",
+		"伊藤",
+		"渡辺",
+		"山本",
+		"中村",
+		"小林",
+		"加藤",
+		"吉田",
+		"山田",
+		"佐々木",
+		"山口",
+		"松本",
+		"井上",
+		"木村",
+		"林",
+		"斎藤",
+		"清水",
+		"山崎",
+		"森",
+		"池田",
+		"橋本",
+		"阿部",
+		"石川",
+		"山下",
+		"中島",
+		"石井",
+		"小川",
+	},
+	Cities: []string{
+		"東京",
+		"横浜",
+		"大阪",
+		"名古屋",
+		"札幌",
+		"福岡",
+		"神戸",
+		"川崎",
+		"京都",
+		"さいたま",
+		"広島",
+		"仙台",
+		"千葉",
+		"北九州",
+		"堺",
+		"浜松",
+		"新潟",
+		"熊本",
+		"相模原",
+		"岡山",
+		"静岡",
+		"船橋",
+		"鹿児島",
+		"八王子",
+		"姫路",
+		"宇都宮",
+		"松山",
+		"金沢",
+		"長崎",
+		"那覇",
+	},
+	Streets: []string{
+		"中央通り",
+		"昭和通り",
+		"明治通り",
+		"青山通り",
+		"靖国通り",
+		"外堀通り",
+		"晴海通り",
+		"山手通り",
+		"環状七号線",
+		"表参道",
+		"御堂筋",
+		"堺筋",
+		"四条通",
+		"河原町通",
+		"烏丸通",
+		"大通",
+		"駅前通り",
+		"本町通り",
+		"桜通",
+		"錦通",
+		"国際通り",
+		"天神西通り",
+		"元町通",
+		"柳通り",
+		"八重洲通り",
+	},
+}
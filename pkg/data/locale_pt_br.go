@@ -0,0 +1,137 @@
+package data
+
+var ptBR = Locale{
+	Country: "BR",
+	Firstnames: []string{
+		"Ana",
+		"Antônio",
+		"Beatriz",
+		"Bruna",
+		"Bruno",
+		"Camila",
+		"Carlos",
+		"Daniela",
+		"Davi",
+		"Eduardo",
+		"Fernanda",
+		"Francisco",
+		"Gabriel",
+		"Gabriela",
+		"Guilherme",
+		"Heitor",
+		"Helena",
+		"João",
+		"José",
+		"Juliana",
+		"Larissa",
+		"Letícia",
+		"Lucas",
+		"Luiz",
+		"Maria",
+		"Mariana",
+		"Matheus",
+		"Paulo",
+		"Pedro",
+		"Rafael",
+		"Sofia",
+		"Thiago",
+	},
+	Lastnames: []string{
+		"Almeida",
+		"Alves",
+		"Araújo",
+		"Barbosa",
+		"Barros",
+		"Cardoso",
+		"Carvalho",
+		"Castro",
+		"Costa",
+		"Dias",
+		"Ferreira",
+		"Fernandes",
+		"Gomes",
+		"Lima",
+		"Lopes",
+		"Martins",
+		"Melo",
+		"Mendes",
+		"Moreira",
+		"Nascimento",
+		"Oliveira",
+		"Pereira",
+		"Ribeiro",
+		"Rocha",
+		"Rodrigues",
+		"Santos",
+		"Silva",
+		"Soares",
+		"Sousa",
+		"Souza",
+		"Teixeira",
+		"Vieira",
+	},
+	Cities: []string{
+		"São Paulo",
+		"Rio de Janeiro",
+		"Brasília",
+		"Salvador",
+		"Fortaleza",
+		"Belo Horizonte",
+		"Manaus",
+		"Curitiba",
+		"Recife",
+		"Goiânia",
+		"Belém",
+		"Porto Alegre",
+		"Guarulhos",
+		"Campinas",
+		"São Luís",
+		"São Gonçalo",
+		"Maceió",
+		"Duque de Caxias",
+		"Natal",
+		"Teresina",
+		"Campo Grande",
+		"São Bernardo do Campo",
+		"João Pessoa",
+		"Osasco",
+		"Santo André",
+		"Ribeirão Preto",
+		"Uberlândia",
+		"Sorocaba",
+		"Contagem",
+		"Florianópolis",
+	},
+	Streets: []string{
+		"Rua Sete de Setembro",
+		"Rua Quinze de Novembro",
+		"Rua Tiradentes",
+		"Rua São José",
+		"Rua Santa Luzia",
+		"Rua das Flores",
+		"Rua da Paz",
+		"Rua Principal",
+		"Rua Dom Pedro II",
+		"Rua Rui Barbosa",
+		"Rua Floriano Peixoto",
+		"Rua Marechal Deodoro",
+		"Rua Duque de Caxias",
+		"Rua Castro Alves",
+		"Rua Bela Vista",
+		"Avenida Paulista",
+		"Avenida Brasil",
+		"Avenida Getúlio Vargas",
+		"Avenida Presidente Vargas",
+		"Avenida Atlântica",
+		"Avenida Rio Branco",
+		"Avenida Ipiranga",
+		"Travessa São Pedro",
+		"Alameda Santos",
+		"Praça da Sé",
+		"Rua Augusta",
+		"Rua Oscar Freire",
+		"Rua da Consolação",
+		"Rua Barão de Mauá",
+		"Rua Boa Vista",
+	},
+}
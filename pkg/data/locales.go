@@ -0,0 +1,26 @@
+package data
+
+// A Locale holds the datasets specific to a language and region. Empty
+// datasets fall back to the English ones
+type Locale struct {
+	// Country is the 2-digit country code of the locale. It selects the
+	// numbering plan of phone numbers
+	Country string
+	// FamilyNameFirst is true for locales that write the family name before
+	// the given name
+	FamilyNameFirst bool
+	Firstnames      []string
+	Lastnames       []string
+	Cities          []string
+	Streets         []string
+}
+
+// Locales is a map of locale names in the form language_REGION to their
+// datasets
+var Locales = map[string]Locale{
+	"en_US": {Country: "US"},
+	"de_DE": deDE,
+	"fr_FR": frFR,
+	"ja_JP": jaJP,
+	"pt_BR": ptBR,
+}
@@ -0,0 +1,50 @@
+package data
+
+// MIMETypes is an array of common media types
+var MIMETypes = []string{
+	"application/gzip",
+	"application/javascript",
+	"application/json",
+	"application/ld+json",
+	"application/msword",
+	"application/octet-stream",
+	"application/pdf",
+	"application/rtf",
+	"application/vnd.ms-excel",
+	"application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+	"application/vnd.openxmlformats-officedocument.wordprocessingml.document",
+	"application/x-www-form-urlencoded",
+	"application/xml",
+	"application/zip",
+	"audio/aac",
+	"audio/mpeg",
+	"audio/ogg",
+	"audio/wav",
+	"audio/webm",
+	"font/otf",
+	"font/ttf",
+	"font/woff",
+	"font/woff2",
+	"image/avif",
+	"image/bmp",
+	"image/gif",
+	"image/jpeg",
+	"image/png",
+	"image/svg+xml",
+	"image/tiff",
+	"image/webp",
+	"multipart/form-data",
+	"text/calendar",
+	"text/css",
+	"text/csv",
+	"text/html",
+	"text/javascript",
+	"text/markdown",
+	"text/plain",
+	"text/xml",
+	"video/mp4",
+	"video/mpeg",
+	"video/ogg",
+	"video/quicktime",
+	"video/webm",
+}
@@ -0,0 +1,3 @@
+package data
+
+var Nasdaq = []string{"Activision Blizzard, Inc", "Adobe Systems Incorporated", "Akamai Technologies, Inc.", "Alexion Pharmaceuticals, Inc.", "Amazon.com, Inc.", "Amgen Inc.", "Apple Inc.", "Applied Materials, Inc.", "Autodesk, Inc.", "Automatic Data Processing, Inc.", "Baidu, Inc.", "Bed Bath & Beyond Inc.", "Biogen Idec Inc.", "Broadcom Corporation", "CA, Inc.", "Celgene Corporation", "Cerner Corporation", "Cisco Systems, Inc.", "Citrix Systems, Inc.", "Cognizant Technology Solutions Corporation", "Comcast Corporation", "Costco Wholesale Corporation", "eBay Inc.", "Electronic Arts Inc.", "Expedia, Inc.", "Facebook, Inc.", "Gilead Sciences, Inc.", "Google Inc.", "Intel Corporation", "Intuit Inc.", "Mattel, Inc.", "Microsoft Corporation", "NetApp, Inc.", "Netflix, Inc.", "NVIDIA Corporation", "QUALCOMM Incorporated", "Starbucks Corporation", "Symantec Corporation", "Texas Instruments Incorporated", "Yahoo! Inc."}
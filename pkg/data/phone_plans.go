@@ -0,0 +1,70 @@
+package data
+
+// A PhonePlan describes the numbering plan of a country
+type PhonePlan struct {
+	// Trunk is the prefix of numbers in national format (example: 0)
+	Trunk string
+	// Numbers are patterns of national significant numbers. The first group
+	// is the area code, # is a random digit and N a random digit from 2 to 9
+	Numbers []string
+	// Parens wraps the area code in parentheses in national format
+	Parens bool
+}
+
+// PhonePlans is a map of 2-digit country codes to their numbering plans
+var PhonePlans = map[string]PhonePlan{
+	"AT": {
+		Trunk:   "0",
+		Numbers: []string{"1 N######", "316 N#####", "512 N#####", "662 N#####", "664 #######", "676 #######", "699 ########"},
+	},
+	"AU": {
+		Trunk:   "0",
+		Numbers: []string{"2 N### ####", "3 N### ####", "7 N### ####", "8 N### ####", "4## ### ###"},
+	},
+	"BR": {
+		Numbers: []string{"11 9####-####", "21 9####-####", "31 9####-####", "11 N###-####", "61 N###-####", "71 N###-####"},
+		Parens:  true,
+	},
+	"CA": {
+		Numbers: []string{"416 N##-####", "514 N##-####", "604 N##-####", "403 N##-####", "613 N##-####"},
+		Parens:  true,
+	},
+	"CH": {
+		Trunk:   "0",
+		Numbers: []string{"44 N## ## ##", "22 N## ## ##", "31 N## ## ##", "61 N## ## ##", "76 ### ## ##", "78 ### ## ##", "79 ### ## ##"},
+	},
+	"DE": {
+		Trunk:   "0",
+		Numbers: []string{"30 N#######", "40 N#######", "89 N#######", "69 N#######", "221 N######", "151 ########", "160 #######", "170 ########", "176 ########"},
+	},
+	"ES": {
+		Numbers: []string{"91 ### ## ##", "93 ### ## ##", "95 ### ## ##", "96 ### ## ##", "6## ### ###"},
+	},
+	"FR": {
+		Trunk:   "0",
+		Numbers: []string{"1 ## ## ## ##", "2 ## ## ## ##", "3 ## ## ## ##", "4 ## ## ## ##", "5 ## ## ## ##", "6 ## ## ## ##", "7 ## ## ## ##"},
+	},
+	"GB": {
+		Trunk:   "0",
+		Numbers: []string{"20 N### ####", "121 N## ####", "131 N## ####", "161 N## ####", "113 N## ####", "7### ######"},
+	},
+	"IN": {
+		Trunk:   "0",
+		Numbers: []string{"11 N#######", "22 N#######", "80 N#######", "9#### #####", "8#### #####", "7#### #####"},
+	},
+	"IT": {
+		Numbers: []string{"06 N### ####", "02 N### ####", "011 N## ####", "055 N## ####", "081 N## ####", "33# ### ####", "34# ### ####"},
+	},
+	"JP": {
+		Trunk:   "0",
+		Numbers: []string{"3-N###-####", "6-N###-####", "52-N##-####", "11-N##-####", "92-N##-####", "90-####-####", "80-####-####", "70-####-####"},
+	},
+	"NL": {
+		Trunk:   "0",
+		Numbers: []string{"20 N## ####", "10 N## ####", "70 N## ####", "30 N## ####", "6 ########"},
+	},
+	"US": {
+		Numbers: []string{"212 N##-####", "415 N##-####", "312 N##-####", "617 N##-####", "305 N##-####", "206 N##-####", "512 N##-####", "702 N##-####"},
+		Parens:  true,
+	},
+}
@@ -0,0 +1,45 @@
+package data
+
+// Streets is an array of US street names
+var Streets = []string{
+	"Main Street",
+	"Church Street",
+	"High Street",
+	"Elm Street",
+	"Washington Street",
+	"Park Avenue",
+	"Walnut Street",
+	"Maple Avenue",
+	"Oak Street",
+	"Pine Street",
+	"Cedar Lane",
+	"Lake Street",
+	"Hill Street",
+	"Spring Street",
+	"Center Street",
+	"Chestnut Street",
+	"Broadway",
+	"Lincoln Avenue",
+	"Jefferson Street",
+	"Madison Avenue",
+	"Franklin Street",
+	"Jackson Street",
+	"Willow Street",
+	"Sunset Boulevard",
+	"River Road",
+	"Mill Street",
+	"School Street",
+	"Water Street",
+	"North Street",
+	"South Street",
+	"Ridge Road",
+	"Highland Avenue",
+	"Meadow Lane",
+	"Prospect Avenue",
+	"Pleasant Street",
+	"Cherry Lane",
+	"Dogwood Drive",
+	"Forest Avenue",
+	"Grove Street",
+	"Union Street",
+}
@@ -0,0 +1,94 @@
+package data
+
+// A Browser describes the user agents of a browser. Template takes the platform
+// and a major version between MinVersion and MaxVersion
+type Browser struct {
+	Template   string
+	Platforms  []string
+	MinVersion int
+	MaxVersion int
+}
+
+var (
+	windows = []string{"Windows NT 10.0; Win64; x64"}
+	macOS   = []string{"Macintosh; Intel Mac OS X 10_15_7"}
+	linux   = []string{"X11; Linux x86_64", "X11; Ubuntu; Linux x86_64", "X11; Fedora; Linux x86_64"}
+	android = []string{"Linux; Android 14; Pixel 8", "Linux; Android 13; SM-S918B", "Linux; Android 13; SM-A546B", "Linux; Android 12; moto g(60)"}
+)
+
+func platforms(lists ...[]string) (all []string) {
+	for _, list := range lists {
+		all = append(all, list...)
+	}
+	return all
+}
+
+// DesktopBrowsers is an array of desktop browsers along with the operating
+// systems they run on
+var DesktopBrowsers = []Browser{
+	{
+		Template:   "Mozilla/5.0 (%s) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%d.0.0.0 Safari/537.36",
+		Platforms:  platforms(windows, macOS, linux),
+		MinVersion: 110,
+		MaxVersion: 124,
+	},
+	{
+		Template:   "Mozilla/5.0 (%[1]s; rv:%[2]d.0) Gecko/20100101 Firefox/%[2]d.0",
+		Platforms:  platforms(windows, macOS, linux),
+		MinVersion: 110,
+		MaxVersion: 124,
+	},
+	{
+		Template:   "Mozilla/5.0 (%[1]s) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%[2]d.0.0.0 Safari/537.36 Edg/%[2]d.0.0.0",
+		Platforms:  platforms(windows, macOS),
+		MinVersion: 110,
+		MaxVersion: 124,
+	},
+	{
+		Template:   "Mozilla/5.0 (%s) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%d.0 Safari/605.1.15",
+		Platforms:  macOS,
+		MinVersion: 15,
+		MaxVersion: 17,
+	},
+}
+
+// MobileBrowsers is an array of mobile browsers along with the operating
+// systems they run on
+var MobileBrowsers = []Browser{
+	{
+		Template:   "Mozilla/5.0 (%[1]s; CPU iPhone OS %[2]d_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%[2]d.0 Mobile/15E148 Safari/604.1",
+		Platforms:  []string{"iPhone"},
+		MinVersion: 15,
+		MaxVersion: 17,
+	},
+	{
+		Template:   "Mozilla/5.0 (%[1]s; CPU OS %[2]d_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/%[2]d.0 Mobile/15E148 Safari/604.1",
+		Platforms:  []string{"iPad"},
+		MinVersion: 15,
+		MaxVersion: 17,
+	},
+	{
+		Template:   "Mozilla/5.0 (%s) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/%d.0.0.0 Mobile Safari/537.36",
+		Platforms:  android,
+		MinVersion: 110,
+		MaxVersion: 124,
+	},
+	{
+		Template:   "Mozilla/5.0 (%[1]s; rv:%[2]d.0) Gecko/%[2]d.0 Firefox/%[2]d.0",
+		Platforms:  []string{"Android 14; Mobile", "Android 13; Mobile"},
+		MinVersion: 110,
+		MaxVersion: 124,
+	},
+}
+
+// Bots is an array of crawler and command line client user agents
+var Bots = []string{
+	"Mozilla/5.0 (compatible; Googlebot/2.1; +http://www.google.com/bot.html)",
+	"Mozilla/5.0 (compatible; bingbot/2.0; +http://www.bing.com/bingbot.htm)",
+	"Mozilla/5.0 (compatible; YandexBot/3.0; +http://yandex.com/bots)",
+	"Mozilla/5.0 (compatible; DuckDuckBot-Https/1.1; https://duckduckgo.com/duckduckbot)",
+	"curl/8.4.0",
+	"Wget/1.21.4",
+	"python-requests/2.31.0",
+	"Go-http-client/1.1",
+}
@@ -0,0 +1,84 @@
+package fakedata
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+)
+
+const accessLogTime = "02/Jan/2006:15:04:05 -0700"
+
+type logClient struct {
+	ip        string
+	userAgent string
+	lastPath  string
+}
+
+// accessLog returns a generator of access log lines in the combined log format.
+// Lines share a pool of clients and a site so that IPs, user agents and
+// referers repeat the way they do in real traffic, and timestamps only move
+// forward. Apache logs 0 bytes as -, nginx as 0
+func (f factory) accessLog(zeroBytes string) func() string {
+	ip, _ := f.ipv4("")
+	statusCode, _ := f.httpStatus("2xx,3xx,4xx,5xx")
+	agent, _ := f.userAgent("")
+	path, _ := f.urlPath("")
+	site := "https://" + f.domain()
+
+	method := f.withWeightedList(
+		[]string{"GET", "POST", "PUT", "DELETE", "HEAD", "OPTIONS", "PATCH"},
+		[]int{80, 12, 3, 2, 1, 1, 1},
+	)
+	protocol := f.withWeightedList([]string{"HTTP/1.1", "HTTP/2.0", "HTTP/1.0"}, []int{70, 28, 2})
+	asset := f.withList([]string{"css", "js", "png", "jpg", "svg", "woff2"})
+
+	newClient := func() logClient {
+		return logClient{ip: ip(), userAgent: agent(), lastPath: "-"}
+	}
+
+	clients := make([]logClient, 20)
+	for i := range clients {
+		clients[i] = newClient()
+	}
+
+	now := time.Now().Add(-time.Hour)
+
+	return func() string {
+		now = now.Add(time.Duration(f.rand.Intn(5000)) * time.Millisecond)
+
+		i := f.rand.Intn(len(clients))
+		if f.rand.Intn(20) == 0 {
+			clients[i] = newClient()
+		}
+		client := &clients[i]
+
+		request := path()
+		if f.rand.Intn(3) == 0 {
+			request = fmt.Sprintf("/static/%s.%s", f.slug(), asset())
+		}
+
+		referer := "-"
+		if client.lastPath != "-" {
+			referer = site + client.lastPath
+		}
+		client.lastPath = request
+
+		status := statusCode()
+		bytes := zeroBytes
+		if status != "204" && status != "304" {
+			bytes = strconv.Itoa(200 + f.rand.Intn(50000))
+		}
+
+		return fmt.Sprintf(`%s - - [%s] "%s %s %s" %s %s "%s" "%s"`,
+			client.ip,
+			now.Format(accessLogTime),
+			method(),
+			request,
+			protocol(),
+			status,
+			bytes,
+			referer,
+			client.userAgent,
+		)
+	}
+}
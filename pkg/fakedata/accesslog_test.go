@@ -0,0 +1,55 @@
+package fakedata_test
+
+import (
+	"regexp"
+	"testing"
+	"time"
+)
+
+var combinedLog = regexp.MustCompile(`^(\S+) - - \[([^\]]+)\] "([A-Z]+) (/\S*) (HTTP/[0-9.]+)" (\d{3}) (\d+|-) "([^"]*)" "([^"]+)"$`)
+
+func TestAccessLog(t *testing.T) {
+	tests := []struct {
+		name      string
+		zeroBytes string
+	}{
+		{"log.apache", "-"},
+		{"log.nginx", "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fn := gens.FindByName(tt.name).Func
+			ips := make(map[string]int)
+			var last time.Time
+
+			for i := 0; i < 1000; i++ {
+				line := fn()
+				match := combinedLog.FindStringSubmatch(line)
+				if match == nil {
+					t.Fatalf("expected %s to be in the combined log format, but was not", line)
+				}
+
+				ts, err := time.Parse("02/Jan/2006:15:04:05 -0700", match[2])
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+
+				if ts.Before(last) {
+					t.Fatalf("expected %s to be after %s, but was not", ts, last)
+				}
+				last = ts
+
+				if (match[6] == "204" || match[6] == "304") && match[7] != tt.zeroBytes {
+					t.Fatalf("expected %s to log empty responses as %s, but did not", line, tt.zeroBytes)
+				}
+
+				ips[match[1]]++
+			}
+
+			if len(ips) > 500 {
+				t.Errorf("expected clients to make several requests, but got %d different IPs in 1000 lines", len(ips))
+			}
+		})
+	}
+}
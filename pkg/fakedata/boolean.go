@@ -0,0 +1,49 @@
+package fakedata
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// booleans maps the way true is written to the way false is
+var booleans = map[string]string{"true": "false", "1": "0", "t": "f"}
+
+// boolean generates true with a probability, 0.5 by default, followed by an
+// optional style: true (true/false), 1 (1/0) or t (t/f) (example: 0.3,t)
+func (f factory) boolean(options string) (func() string, error) {
+	p, style := 0.5, "true"
+
+	parts := strings.Split(options, ",")
+	if len(parts) > 2 {
+		return nil, fmt.Errorf("%s must be a probability and a style (example: 0.3,t)", options)
+	}
+
+	if parts[0] != "" {
+		n, err := strconv.ParseFloat(parts[0], 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert probability: %v", err)
+		}
+
+		if n < 0 || n > 1 {
+			return nil, fmt.Errorf("probability(%s) must be between 0 and 1", parts[0])
+		}
+
+		p = n
+	}
+
+	if len(parts) > 1 {
+		style = parts[1]
+		if _, ok := booleans[style]; !ok {
+			return nil, fmt.Errorf("unknown style: %s. Available styles: 1|t|true", style)
+		}
+	}
+
+	return func() string {
+		if f.rand.Float64() < p {
+			return style
+		}
+
+		return booleans[style]
+	}, nil
+}
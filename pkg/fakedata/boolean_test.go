@@ -0,0 +1,43 @@
+package fakedata_test
+
+import (
+	"testing"
+)
+
+func TestBool(t *testing.T) {
+	tests := []struct {
+		options  string
+		yes, no  string
+		min, max int
+	}{
+		{"", "true", "false", 4000, 6000},
+		{"0.3", "true", "false", 2000, 4000},
+		{"0", "true", "false", 0, 0},
+		{"1,1", "1", "0", 10000, 10000},
+		{"0.9,t", "t", "f", 8000, 10000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.options, func(t *testing.T) {
+			fn, err := gens.FindByName("bool").CustomFunc(tt.options)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			trues := 0
+			for i := 0; i < 10000; i++ {
+				switch actual := fn(); actual {
+				case tt.yes:
+					trues++
+				case tt.no:
+				default:
+					t.Fatalf("expected either %s or %s, but got %s", tt.yes, tt.no, actual)
+				}
+			}
+
+			if trues < tt.min || trues > tt.max {
+				t.Errorf("expected between %d and %d trues, but got %d", tt.min, tt.max, trues)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"io"
 	"strings"
+
+	"github.com/lucapette/fakedata/pkg/data"
 )
 
 // A Column represents one field of data to generate
@@ -11,6 +13,8 @@ type Column struct {
 	Name     string
 	Key      string
 	Generate func() string
+	// newRow tells the generators of the column that a new row starts
+	newRow func()
 }
 
 // Columns is an array of Column
@@ -18,13 +22,19 @@ type Columns []Column
 
 // NewColumns returns an array of Columns using keys as a specification.
 // It returns an error with a line for each unknown key
-func NewColumns(keys []string) (cols Columns, err error) {
+func NewColumns(keys []string) (Columns, error) {
+	return NewLocalizedColumns(keys, data.Locale{})
+}
+
+// NewLocalizedColumns is like NewColumns, but generators use the datasets of
+// locale
+func NewLocalizedColumns(keys []string, locale data.Locale) (cols Columns, err error) {
 	cols = make(Columns, len(keys))
 
-	f := newFactory()
+	f := newFactory(locale, newRand(0))
 
 	for i, k := range keys {
-		specs := strings.Split(k, ":")
+		specs := strings.SplitN(k, ":", 2)
 
 		values := strings.Split(specs[0], "=")
 		var name, key, options string
@@ -49,6 +59,7 @@ func NewColumns(keys []string) (cols Columns, err error) {
 		cols[i].Name = name
 		cols[i].Key = key
 		cols[i].Generate = fn
+		cols[i].newRow = f.resetRows
 	}
 
 	return cols, err
@@ -57,6 +68,12 @@ func NewColumns(keys []string) (cols Columns, err error) {
 // GenerateRow generates a row of fake data using columns
 // in the specified format
 func (columns Columns) GenerateRow(f io.Writer, formatter Formatter) {
+	for _, column := range columns {
+		if column.newRow != nil {
+			column.newRow()
+		}
+	}
+
 	values := make([]string, len(columns))
 	for i, column := range columns {
 		values[i] = column.Generate()
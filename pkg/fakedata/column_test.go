@@ -3,6 +3,7 @@ package fakedata_test
 import (
 	"bytes"
 	"fmt"
+	"net/netip"
 	"reflect"
 	"regexp"
 	"strconv"
@@ -53,11 +54,277 @@ func TestNewColumns(t *testing.T) {
 			input:   []string{"email", "domain", "unsupportedgenerator"},
 			wantErr: true,
 		},
+		{
+			name:     "constraints with colons",
+			input:    []string{"ipv6:fd00::/8"},
+			expected: fakedata.Columns{{Key: "ipv6", Name: "ipv6"}},
+			wantErr:  false,
+		},
 		{
 			name:    "one column, all fails",
 			input:   []string{"madeupgenerator"},
 			wantErr: true,
 		},
+		{
+			name:    "creditcard:discover",
+			input:   []string{"creditcard:discover"},
+			wantErr: true,
+		},
+		{
+			name:    "iban:ZZ",
+			input:   []string{"iban:ZZ"},
+			wantErr: true,
+		},
+		{
+			name:    "ean13:40a",
+			input:   []string{"ean13:40a"},
+			wantErr: true,
+		},
+		{
+			name:    "upc:1234567890123",
+			input:   []string{"upc:1234567890123"},
+			wantErr: true,
+		},
+		{
+			name:    "ipv4:fd00::/8",
+			input:   []string{"ipv4:fd00::/8"},
+			wantErr: true,
+		},
+		{
+			name:    "ipv6:10.0.0.0/8",
+			input:   []string{"ipv6:10.0.0.0/8"},
+			wantErr: true,
+		},
+		{
+			name:    "ipv4:10.0.0.0",
+			input:   []string{"ipv4:10.0.0.0"},
+			wantErr: true,
+		},
+		{
+			name:    "mac.address:00:1A",
+			input:   []string{"mac.address:00:1A"},
+			wantErr: true,
+		},
+		{
+			name:    "cidr:ipv5",
+			input:   []string{"cidr:ipv5"},
+			wantErr: true,
+		},
+		{
+			name:    "port:1,70000",
+			input:   []string{"port:1,70000"},
+			wantErr: true,
+		},
+		{
+			name:    "port:-5,10",
+			input:   []string{"port:-5,10"},
+			wantErr: true,
+		},
+		{
+			name:    "port:0",
+			input:   []string{"port:0"},
+			wantErr: true,
+		},
+		{
+			name:    "port:9000,8000",
+			input:   []string{"port:9000,8000"},
+			wantErr: true,
+		},
+		{
+			name:    "port:1,2,3",
+			input:   []string{"port:1,2,3"},
+			wantErr: true,
+		},
+		{
+			name:    "port:http",
+			input:   []string{"port:http"},
+			wantErr: true,
+		},
+		{
+			name:    "url:ht1p",
+			input:   []string{"url:ht1p"},
+			wantErr: true,
+		},
+		{
+			name:    "url:https,deep",
+			input:   []string{"url:https,deep"},
+			wantErr: true,
+		},
+		{
+			name:    "url.path:-1",
+			input:   []string{"url.path:-1"},
+			wantErr: true,
+		},
+		{
+			name:    "url.query:many",
+			input:   []string{"url.query:many"},
+			wantErr: true,
+		},
+		{
+			name:    "http.status:6xx",
+			input:   []string{"http.status:6xx"},
+			wantErr: true,
+		},
+		{
+			name:    "http.status:299",
+			input:   []string{"http.status:299"},
+			wantErr: true,
+		},
+		{
+			name:    "http.useragent:tablet",
+			input:   []string{"http.useragent:tablet"},
+			wantErr: true,
+		},
+		{
+			name:    "http.header:X-Made-Up",
+			input:   []string{"http.header:X-Made-Up"},
+			wantErr: true,
+		},
+		{
+			name:    "mime.type:movie",
+			input:   []string{"mime.type:movie"},
+			wantErr: true,
+		},
+		{
+			name:    "words:many",
+			input:   []string{"words:many"},
+			wantErr: true,
+		},
+		{
+			name:    "words:5,2",
+			input:   []string{"words:5,2"},
+			wantErr: true,
+		},
+		{
+			name:    "paragraph:1,2,3",
+			input:   []string{"paragraph:1,2,3"},
+			wantErr: true,
+		},
+		{
+			name:    "text:-5",
+			input:   []string{"text:-5"},
+			wantErr: true,
+		},
+		{
+			name:    "text:short",
+			input:   []string{"text:short"},
+			wantErr: true,
+		},
+		{
+			name:    "markov without a path",
+			input:   []string{"markov"},
+			wantErr: true,
+		},
+		{
+			name:    "markov with a missing file",
+			input:   []string{"markov:missing.txt"},
+			wantErr: true,
+		},
+		{
+			name:    "markov with an invalid order",
+			input:   []string{"markov:" + corpus + ",two"},
+			wantErr: true,
+		},
+		{
+			name:    "markov with a zero order",
+			input:   []string{"markov:" + corpus + ",0"},
+			wantErr: true,
+		},
+		{
+			name:    "markov with an order longer than sentences",
+			input:   []string{"markov:" + corpus + ",50"},
+			wantErr: true,
+		},
+		{
+			name:    "csv with no column",
+			input:   []string{"csv:" + products},
+			wantErr: true,
+		},
+		{
+			name:    "csv with an empty column",
+			input:   []string{"csv:" + products + ","},
+			wantErr: true,
+		},
+		{
+			name:    "csv with a missing file",
+			input:   []string{"csv:missing.csv,sku"},
+			wantErr: true,
+		},
+		{
+			name:    "csv with an unknown column",
+			input:   []string{"csv:" + products + ",color"},
+			wantErr: true,
+		},
+		{
+			name:    "csv with a position out of range",
+			input:   []string{"csv:" + products + ",5"},
+			wantErr: true,
+		},
+		{
+			name:    "csv.row with no column",
+			input:   []string{"csv.row:" + products},
+			wantErr: true,
+		},
+		{
+			name:    "csv.row with an empty column",
+			input:   []string{"csv.row:" + products + ","},
+			wantErr: true,
+		},
+		{
+			name:    "csv.row with a missing file",
+			input:   []string{"csv.row:missing.csv,sku"},
+			wantErr: true,
+		},
+		{
+			name:    "csv.row with an unknown column",
+			input:   []string{"csv.row:" + products + ",color"},
+			wantErr: true,
+		},
+		{
+			name:    "csv.row with a position out of range",
+			input:   []string{"csv.row:" + products + ",5"},
+			wantErr: true,
+		},
+		{
+			name:    "bool:often",
+			input:   []string{"bool:often"},
+			wantErr: true,
+		},
+		{
+			name:    "bool:1.5",
+			input:   []string{"bool:1.5"},
+			wantErr: true,
+		},
+		{
+			name:    "bool:-0.1",
+			input:   []string{"bool:-0.1"},
+			wantErr: true,
+		},
+		{
+			name:    "bool:0.5,yes",
+			input:   []string{"bool:0.5,yes"},
+			wantErr: true,
+		},
+		{
+			name:    "bool:0.5,t,f",
+			input:   []string{"bool:0.5,t,f"},
+			wantErr: true,
+		},
+		{
+			name:    "currency.symbol:Euro",
+			input:   []string{"currency.symbol:Euro"},
+			wantErr: true,
+		},
+		{
+			name:    "product.sku:sku",
+			input:   []string{"product.sku:sku"},
+			wantErr: true,
+		},
+		{
+			name:    `product.sku:\ABC-\#`,
+			input:   []string{`product.sku:\ABC-\#`},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -159,6 +426,206 @@ func TestGenerateRow(t *testing.T) {
 			args{[]string{"username", "domain"}, tab},
 			`^[a-zA-Z0-9]{2,}\t.+?\..+?$`,
 		},
+		{
+			"mac.address",
+			args{[]string{"mac.address"}, def},
+			`^[0-9A-F][048C](:[0-9A-F]{2}){5}$`,
+		},
+		{
+			"mac.address:local",
+			args{[]string{"mac.address:local"}, def},
+			`^[0-9A-F][26AE](:[0-9A-F]{2}){5}$`,
+		},
+		{
+			"mac.address:00:1a:2b",
+			args{[]string{"mac.address:00:1a:2b"}, def},
+			`^00:1A:2B(:[0-9A-F]{2}){3}$`,
+		},
+		{
+			"url",
+			args{[]string{"url"}, def},
+			`^https?://[^/\s]+/([a-z-]+(/[a-z-]+)*)?([?#]\S*)?$`,
+		},
+		{
+			"url:https",
+			args{[]string{"url:https"}, def},
+			`^https://[^/\s]+/([a-z-]+(/[a-z-]+)*)?([?#]\S*)?$`,
+		},
+		{
+			"url:ftp,2",
+			args{[]string{"url:ftp,2"}, def},
+			`^ftp://[^/\s]+/[a-z-]+/[a-z-]+([?#]\S*)?$`,
+		},
+		{
+			"url:,0",
+			args{[]string{"url:,0"}, def},
+			`^https?://[^/\s]+/([?#]\S*)?$`,
+		},
+		{
+			"url.path",
+			args{[]string{"url.path"}, def},
+			`^/([a-z-]+(/[a-z-]+){0,2})?$`,
+		},
+		{
+			"url.query:3",
+			args{[]string{"url.query:3"}, def},
+			`^[a-z-]+\d*=[a-z0-9-]+(&[a-z-]+\d*=[a-z0-9-]+){2}$`,
+		},
+		{
+			"http.status:4xx",
+			args{[]string{"http.status:4xx"}, def},
+			`^4\d{2}$`,
+		},
+		{
+			"http.status:4xx,5xx",
+			args{[]string{"http.status:4xx,5xx"}, def},
+			`^[45]\d{2}$`,
+		},
+		{
+			"http.status:404",
+			args{[]string{"http.status:404"}, def},
+			`^404$`,
+		},
+		{
+			"http.useragent",
+			args{[]string{"http.useragent"}, def},
+			`^\S+/[^%]+$`,
+		},
+		{
+			"http.useragent:desktop",
+			args{[]string{"http.useragent:desktop"}, def},
+			`^Mozilla/5\.0 \([^%]+$`,
+		},
+		{
+			"http.useragent:mobile",
+			args{[]string{"http.useragent:mobile"}, def},
+			`^Mozilla/5\.0 \([^%]*Mobile[^%]*$`,
+		},
+		{
+			"http.useragent:bot",
+			args{[]string{"http.useragent:bot"}, def},
+			`^\S+/[^%]+$`,
+		},
+		{
+			"http.header",
+			args{[]string{"http.header"}, def},
+			`^[A-Za-z-]+: [^%]+$`,
+		},
+		{
+			"http.header:content-type",
+			args{[]string{"http.header:content-type"}, def},
+			`^[a-z]+/[a-z0-9.+-]+(; \S+)?$`,
+		},
+		{
+			"mime.type",
+			args{[]string{"mime.type"}, def},
+			`^[a-z]+/[a-z0-9.+-]+$`,
+		},
+		{
+			"mime.type:image",
+			args{[]string{"mime.type:image"}, def},
+			`^image/[a-z0-9.+-]+$`,
+		},
+		{
+			"words",
+			args{[]string{"words"}, def},
+			`^\S+( \S+){2,7}$`,
+		},
+		{
+			"words:5",
+			args{[]string{"words:5"}, def},
+			`^\S+( \S+){4}$`,
+		},
+		{
+			"words:2,4",
+			args{[]string{"words:2,4"}, def},
+			`^\S+( \S+){1,3}$`,
+		},
+		{
+			"words:5,lorem",
+			args{[]string{"words:5,lorem"}, def},
+			`^[a-z]+( [a-z]+){4}$`,
+		},
+		{
+			"words:lorem",
+			args{[]string{"words:lorem"}, def},
+			`^[a-z]+( [a-z]+){2,7}$`,
+		},
+		{
+			"paragraph:2,4,lorem",
+			args{[]string{"paragraph:2,4,lorem"}, def},
+			`^[A-Z][a-z]*( [a-z]+)*\.( [A-Z][a-z]*( [a-z]+)*\.){1,3}$`,
+		},
+		{
+			"text:20,lorem",
+			args{[]string{"text:20,lorem"}, def},
+			`^[A-Z]([A-Za-z .]{0,18}[a-z.])?$`,
+		},
+		{
+			"text:200,lorem",
+			args{[]string{"text:200,lorem"}, def},
+			`^[A-Z]([A-Za-z .]{0,198}[a-z.])?$`,
+		},
+		{
+			"text:1000,lorem",
+			args{[]string{"text:1000,lorem"}, def},
+			`^[A-Z]([A-Za-z .]{0,998}[a-z.])?$`,
+		},
+		{
+			"currency.symbol:EUR",
+			args{[]string{"currency.symbol:EUR"}, def},
+			`^€$`,
+		},
+		{
+			"currency.symbol:usd",
+			args{[]string{"currency.symbol:usd"}, def},
+			`^\$$`,
+		},
+		{
+			"currency.symbol:JPY",
+			args{[]string{"currency.symbol:JPY"}, def},
+			`^¥$`,
+		},
+		{
+			"product.sku",
+			args{[]string{"product.sku"}, def},
+			`^[A-Z]{3}-\d{5}$`,
+		},
+		{
+			"product.sku:AA-####",
+			args{[]string{"product.sku:AA-####"}, def},
+			`^[A-Z]{2}-\d{4}$`,
+		},
+		{
+			"product.sku:#/#",
+			args{[]string{"product.sku:#/#"}, def},
+			`^\d/\d$`,
+		},
+		{
+			"company.name",
+			args{[]string{"company.name"}, def},
+			`^\S(.*\S)?$`,
+		},
+		{
+			"product.name",
+			args{[]string{"product.name"}, def},
+			`^[A-Z][a-z]+(-[A-Z])?[a-z]+$`,
+		},
+		{
+			"job.title",
+			args{[]string{"job.title"}, def},
+			`^\S+( \S+){1,4}$`,
+		},
+		{
+			`product.sku:\ABC-###`,
+			args{[]string{`product.sku:\ABC-###`}, def},
+			`^ABC-\d{3}$`,
+		},
+		{
+			`product.sku:A\#\\#`,
+			args{[]string{`product.sku:A\#\\#`}, def},
+			`^[A-Z]#\\\d$`,
+		},
 	}
 
 	for _, tt := range tests {
@@ -200,6 +667,48 @@ func TestGenerateRowWithIntRanges(t *testing.T) {
 			100,
 			1200,
 		},
+		{
+			"port",
+			args{[]string{"port"}, def},
+			1,
+			65535,
+		},
+		{
+			"port:well-known",
+			args{[]string{"port:well-known"}, def},
+			1,
+			1023,
+		},
+		{
+			"port:registered",
+			args{[]string{"port:registered"}, def},
+			1024,
+			49151,
+		},
+		{
+			"port:dynamic",
+			args{[]string{"port:dynamic"}, def},
+			49152,
+			65535,
+		},
+		{
+			"port:8000,9000",
+			args{[]string{"port:8000,9000"}, def},
+			8000,
+			9000,
+		},
+		{
+			"port:8000",
+			args{[]string{"port:8000"}, def},
+			8000,
+			8000,
+		},
+		{
+			"port:1,65535",
+			args{[]string{"port:1,65535"}, def},
+			1,
+			65535,
+		},
 	}
 
 	for _, tt := range tests {
@@ -282,6 +791,175 @@ func TestGenerateRowWithDateRanges(t *testing.T) {
 	}
 }
 
+func TestGenerateRowWithMoneyRanges(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     args
+		format   string
+		min, max float64
+	}{
+		{
+			"money",
+			args{[]string{"money"}, def},
+			`^\d+\.\d{2}$`,
+			1,
+			1000,
+		},
+		{
+			"money:JPY",
+			args{[]string{"money:JPY"}, def},
+			`^\d+$`,
+			1,
+			1000,
+		},
+		{
+			"money:10,20,USD",
+			args{[]string{"money:10,20,USD"}, def},
+			`^\d+\.\d{2}$`,
+			10,
+			20,
+		},
+		{
+			"money:0.1,0.2",
+			args{[]string{"money:0.1,0.2"}, def},
+			`^0\.\d{2}$`,
+			0.1,
+			0.2,
+		},
+		{
+			"money:1,2,bhd",
+			args{[]string{"money:1,2,bhd"}, def},
+			`^[12]\.\d{3}$`,
+			1,
+			2,
+		},
+		{
+			"money:100,100000,JPY",
+			args{[]string{"money:100,100000,JPY"}, def},
+			`^\d+$`,
+			100,
+			100000,
+		},
+		{
+			"money:0.001,0.019",
+			args{[]string{"money:0.001,0.019"}, def},
+			`^0\.01$`,
+			0.01,
+			0.01,
+		},
+		{
+			"money:0,1e16",
+			args{[]string{"money:0,1e16"}, def},
+			`^\d+\.\d{2}$`,
+			0,
+			1e16,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// this isn't an accurate way of testing random output
+			// but it serves a practical purpose
+			columns, err := fakedata.NewColumns(tt.args.input)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			for index := 0; index < 10000; index++ {
+
+				row := bytes.Buffer{}
+				columns.GenerateRow(&row, tt.args.formatter)
+				value := strings.TrimRight(row.String(), "\n")
+
+				matched, err := regexp.MatchString(tt.format, value)
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+
+				if !matched {
+					t.Fatalf("expected %s to match '%s', but did not", tt.format, value)
+				}
+
+				actual, err := strconv.ParseFloat(value, 64)
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+
+				if !(actual >= tt.min && actual <= tt.max) {
+					t.Fatalf("expected an amount between %v and %v, but got %s", tt.min, tt.max, value)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateRowWithIPRanges(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  args
+		valid func(netip.Addr) bool
+	}{
+		{
+			"ipv4",
+			args{[]string{"ipv4"}, def},
+			func(a netip.Addr) bool { return a.Is4() && !a.IsPrivate() && !a.IsLoopback() && !a.IsMulticast() },
+		},
+		{
+			"ipv4:private",
+			args{[]string{"ipv4:private"}, def},
+			func(a netip.Addr) bool { return a.Is4() && a.IsPrivate() },
+		},
+		{
+			"ipv4:10.0.0.0/8",
+			args{[]string{"ipv4:10.0.0.0/8"}, def},
+			netip.MustParsePrefix("10.0.0.0/8").Contains,
+		},
+		{
+			"ipv4:192.168.1.0/30",
+			args{[]string{"ipv4:192.168.1.0/30"}, def},
+			netip.MustParsePrefix("192.168.1.0/30").Contains,
+		},
+		{
+			"ipv6",
+			args{[]string{"ipv6"}, def},
+			func(a netip.Addr) bool { return a.Is6() && a.IsGlobalUnicast() && !a.IsPrivate() },
+		},
+		{
+			"ipv6:private",
+			args{[]string{"ipv6:private"}, def},
+			func(a netip.Addr) bool { return a.Is6() && a.IsPrivate() },
+		},
+		{
+			"ipv6:fd00::/8",
+			args{[]string{"ipv6:fd00::/8"}, def},
+			netip.MustParsePrefix("fd00::/8").Contains,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// this isn't an accurate way of testing random output
+			// but it serves a practical purpose
+			columns, err := fakedata.NewColumns(tt.args.input)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+			for index := 0; index < 10000; index++ {
+
+				row := bytes.Buffer{}
+				columns.GenerateRow(&row, tt.args.formatter)
+				actual, err := netip.ParseAddr(strings.TrimRight(row.String(), "\n"))
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+
+				if !tt.valid(actual) {
+					t.Fatalf("expected %s to be in the range of %s, but was not", actual, tt.name)
+				}
+			}
+		})
+	}
+}
+
 func TestGenerateRowWithEnum(t *testing.T) {
 	tests := []struct {
 		name     string
@@ -298,6 +976,16 @@ func TestGenerateRowWithEnum(t *testing.T) {
 			args{[]string{"enum:Peter,Olivia,Walter"}, def},
 			[]string{"Peter", "Olivia", "Walter"},
 		},
+		{
+			"csv:products.csv,sku",
+			args{[]string{"csv:" + products + ",sku"}, def},
+			[]string{"SKU-001", "SKU-002", "SKU-003", "SKU-004", "SKU-005"},
+		},
+		{
+			"csv:products.csv,1",
+			args{[]string{"csv:" + products + ",1"}, def},
+			[]string{"SKU-001", "SKU-002", "SKU-003", "SKU-004", "SKU-005"},
+		},
 	}
 
 	for _, tt := range tests {
@@ -328,3 +1016,50 @@ func TestGenerateRowWithEnum(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateRowWithPhoneFormats(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected string
+		wantErr  bool
+	}{
+		{"default", "phone.de", `^\+49\d{9,11}$`, false},
+		{"e164", "phone.fr:e164", `^\+33\d{9}$`, false},
+		{"national", "phone.fr:national", `^0\d( \d{2}){4}$`, false},
+		{"national with parens", "phone.us:national", `^\(\d{3}\) [2-9]\d{2}-\d{4}$`, false},
+		{"international", "phone.jp:international", `^\+81 \d{1,2}-\d{3,4}-\d{4}$`, false},
+		{"no known plan", "phone.ag:international", `^\+1 268 [2-9]\d{2}-\d{4}$`, false},
+		{"no known plan with parens", "phone.ag:national", `^\(268\) [2-9]\d{2}-\d{4}$`, false},
+		{"north american numbering plan", "phone.pr", `^\+1[2-9]\d{2}[2-9]\d{6}$`, false},
+		{"north american numbering plan national", "phone.pr:national", `^\([2-9]\d{2}\) [2-9]\d{2}-\d{4}$`, false},
+		{"unknown format", "phone.de:local", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			columns, err := fakedata.NewColumns([]string{tt.input})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("expected (err != nil) to be %v, but got %v. err: %v", tt.wantErr, err != nil, err)
+			}
+
+			if tt.wantErr {
+				return
+			}
+
+			for index := 0; index < 1000; index++ {
+				row := bytes.Buffer{}
+				columns.GenerateRow(&row, def)
+
+				matched, err := regexp.MatchString(tt.expected, strings.TrimRight(row.String(), "\n"))
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+
+				if !matched {
+					t.Fatalf("expected %s to match '%s', but did not", tt.expected, row.String())
+				}
+			}
+		})
+	}
+}
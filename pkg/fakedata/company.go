@@ -0,0 +1,67 @@
+package fakedata
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lucapette/fakedata/pkg/data"
+)
+
+// companyName returns names of Fortune 500 and NASDAQ companies, or names made
+// of last names, like Smith & Jones LLC
+func (f factory) companyName(last func() string) func() string {
+	return func() string {
+		switch f.rand.Intn(6) {
+		case 0:
+			return f.oneOf(data.Fortune500)
+		case 1:
+			return f.oneOf(data.Nasdaq)
+		case 2:
+			return fmt.Sprintf("%s & %s %s", last(), last(), f.companySuffix())
+		case 3:
+			return fmt.Sprintf("%s-%s", last(), last())
+		default:
+			return fmt.Sprintf("%s %s", last(), f.companySuffix())
+		}
+	}
+}
+
+// productName makes up brand-like names such as Rankfix or Sil-Home
+func (f factory) productName() string {
+	prefix, suffix := f.oneOf(data.ProductNamePrefixes), f.oneOf(data.ProductNameSuffixes)
+	if f.rand.Intn(10) == 0 {
+		return prefix + "-" + strings.ToUpper(suffix[:1]) + suffix[1:]
+	}
+
+	return prefix + suffix
+}
+
+// skuEscapes drops the escaped characters of a pattern, so that what is left
+// are placeholders and literals
+var skuEscapes = strings.NewReplacer(`\\`, "", `\#`, "", `\A`, "")
+
+// productSKU accepts a pattern where # is a digit and A a letter, AAA-##### by
+// default. A backslash makes them literal (example: \ABC-###)
+func (f factory) productSKU(options string) (func() string, error) {
+	pattern := "AAA-#####"
+	if options != "" {
+		if !strings.ContainsAny(skuEscapes.Replace(options), "#A") {
+			return nil, fmt.Errorf("pattern %s must contain at least a # or an A (example: AA-####)", options)
+		}
+		pattern = options
+	}
+
+	return func() string { return f.fillPattern(pattern) }, nil
+}
+
+func (f factory) companySuffix() string {
+	return f.oneOf(data.CompanySuffixes)
+}
+
+func (f factory) jobTitle() string {
+	if f.rand.Intn(2) == 0 {
+		return fmt.Sprintf("%s %s %s", f.oneOf(data.JobLevels), f.oneOf(data.JobAreas), f.oneOf(data.JobRoles))
+	}
+
+	return fmt.Sprintf("%s %s", f.oneOf(data.JobAreas), f.oneOf(data.JobRoles))
+}
@@ -0,0 +1,130 @@
+package fakedata
+
+import (
+	"encoding/csv"
+	"fmt"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// csvFile is a CSV file whose first line is a header
+type csvFile struct {
+	header  []string
+	records [][]string
+}
+
+func readCSV(path string) (*csvFile, error) {
+	filePath := strings.Trim(path, "'\"")
+
+	f, err := os.Open(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read file %s: %v", filePath, err)
+	}
+	defer f.Close()
+
+	records, err := csv.NewReader(f).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("could not parse CSV file %s: %v", filePath, err)
+	}
+
+	if len(records) < 2 {
+		return nil, fmt.Errorf("file %s has no records after the header", filePath)
+	}
+
+	return &csvFile{header: records[0], records: records[1:]}, nil
+}
+
+// column returns the index of a column given its header name or its position,
+// starting from 1
+func (c *csvFile) column(name string) (int, error) {
+	for i, h := range c.header {
+		if h == name {
+			return i, nil
+		}
+	}
+
+	if n, err := strconv.Atoi(name); err == nil && n >= 1 && n <= len(c.header) {
+		return n - 1, nil
+	}
+
+	return 0, fmt.Errorf("unknown column: %s. Available columns: %s", name, strings.Join(c.header, ","))
+}
+
+func (c *csvFile) record(r *rand.Rand) []string {
+	return c.records[r.Intn(len(c.records))]
+}
+
+// csvOptions splits options into a path and a column. The column follows the
+// last comma so that paths can contain commas
+func csvOptions(options string) (path, column string, err error) {
+	i := strings.LastIndex(options, ",")
+	if i <= 0 || i == len(options)-1 {
+		return "", "", fmt.Errorf("%s must be a file path and a column (example: products.csv,name)", options)
+	}
+
+	return options[:i], options[i+1:], nil
+}
+
+func (f factory) csvColumn(options string) (func() string, error) {
+	path, column, err := csvOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := readCSV(path)
+	if err != nil {
+		return nil, err
+	}
+
+	i, err := file.column(column)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() string { return file.record(f.rand)[i] }, nil
+}
+
+// csvRow is the record of a CSV file shared by the columns of a row
+type csvRow struct {
+	file   *csvFile
+	record []string
+}
+
+// csvRow returns a generator of CSV columns that draw from the same record
+// until resetRows, which callers invoke when a new row starts. The factory
+// holds a record per file
+func (f factory) csvRow(options string) (func() string, error) {
+	path, column, err := csvOptions(options)
+	if err != nil {
+		return nil, err
+	}
+
+	// products.csv and ./products.csv are the same file, so they share a record
+	key := filepath.Clean(strings.Trim(path, "'\""))
+	row, ok := f.csvRows[key]
+	if !ok {
+		file, err := readCSV(path)
+		if err != nil {
+			return nil, err
+		}
+
+		row = &csvRow{file: file}
+		f.csvRows[key] = row
+	}
+
+	i, err := row.file.column(column)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() string {
+		if row.record == nil {
+			row.record = row.file.record(f.rand)
+		}
+
+		return row.record[i]
+	}, nil
+}
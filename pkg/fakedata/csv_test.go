@@ -0,0 +1,98 @@
+package fakedata_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/lucapette/fakedata/pkg/fakedata"
+)
+
+const products = "../../testutil/fixtures/products.csv"
+
+var skus = map[string]string{
+	"SKU-001": "Espresso Machine",
+	"SKU-002": `Chef's Knife, 8"`,
+	"SKU-003": "Trail Running Shoes",
+	"SKU-004": "Yoga Mat",
+	"SKU-005": "Desk Lamp",
+}
+
+func TestCSVRow(t *testing.T) {
+	columns, err := fakedata.NewColumns([]string{
+		"sku=csv.row:" + products + ",sku",
+		"name=csv.row:" + products + ",name",
+		"same.name=csv.row:" + products + ",2",
+		"other.name=csv.row:../../testutil/./fixtures/products.csv,name",
+	})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	changed := false
+	previous := ""
+	for i := 0; i < 1000; i++ {
+		row := bytes.Buffer{}
+		columns.GenerateRow(&row, fakedata.NewColumnFormatter("\t"))
+
+		values := strings.Split(strings.TrimSuffix(row.String(), "\n"), "\t")
+		sku, name := values[0], values[1]
+		if skus[sku] != name || values[2] != name || values[3] != name {
+			t.Fatalf("expected %v to come from the same record, but did not", values)
+		}
+
+		changed = changed || (previous != "" && previous != sku)
+		previous = sku
+	}
+
+	if !changed {
+		t.Errorf("expected rows to come from different records, but got %s every time", previous)
+	}
+}
+
+func TestCSVRowWithNewRow(t *testing.T) {
+	gen := gens.FindByName("csv.row")
+	sku, err := gen.CustomFunc(products + ",sku")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	name, err := gen.CustomFunc(products + ",name")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	seen := map[string]bool{}
+	for i := 0; i < 200; i++ {
+		gen.NewRow()
+
+		s := sku()
+		if skus[s] != name() || sku() != s {
+			t.Fatalf("expected %s and %s to come from the same record, but did not", s, name())
+		}
+		seen[s] = true
+	}
+
+	if len(seen) < 2 {
+		t.Errorf("expected rows to come from different records, but got %v", seen)
+	}
+}
+
+func TestCSVRowInTemplates(t *testing.T) {
+	tmpl := fmt.Sprintf("{{ CsvRow %q \"sku\" }}\t{{ CsvRow %[1]q \"name\" }}\t{{ CsvRow %[1]q \"name\" }}\n", products)
+	rows := strings.Split(strings.TrimSuffix(executeRows(t, tmpl, fakedata.TemplateOptions{Limit: 200}), "\n"), "\n")
+
+	seen := map[string]bool{}
+	for _, row := range rows {
+		values := strings.Split(row, "\t")
+		if skus[values[0]] != values[1] || values[1] != values[2] {
+			t.Fatalf("expected %v to come from the same record, but did not", values)
+		}
+		seen[values[0]] = true
+	}
+
+	if len(seen) < 2 {
+		t.Errorf("expected rows to come from different records, but got %v", seen)
+	}
+}
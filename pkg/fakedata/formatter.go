@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 )
 
@@ -43,6 +44,10 @@ func (f *SQLFormatter) Format(columns Columns, values []string) string {
 
 	formattedValues := make([]string, len(columns))
 	for i, value := range values {
+		if columns[i].Key == "bool" && (value == "true" || value == "false") {
+			formattedValues[i] = strings.ToUpper(value)
+			continue
+		}
 		formattedValues[i] = fmt.Sprintf("'%s'", value)
 	}
 
@@ -58,10 +63,16 @@ func (f *SQLFormatter) Format(columns Columns, values []string) string {
 
 // Format as ndjson
 func (f *NdjsonFormatter) Format(columns Columns, values []string) string {
-	data := make(map[string]string, len(columns))
+	data := make(map[string]interface{}, len(columns))
 
 	for i := 0; i < len(columns); i++ {
 		data[columns[i].Name] = values[i]
+
+		if columns[i].Key == "bool" {
+			if b, err := strconv.ParseBool(values[i]); err == nil {
+				data[columns[i].Name] = b
+			}
+		}
 	}
 
 	v, err := json.Marshal(data)
@@ -65,6 +65,28 @@ func TestNdjsonFormatter(t *testing.T) {
 	}
 }
 
+func TestFormattersWithBooleans(t *testing.T) {
+	columns := fakedata.Columns{{Name: "active", Key: "bool"}, {Name: "admin", Key: "bool"}, {Name: "flag", Key: "enum"}}
+	values := []string{"true", "0", "false"}
+
+	tests := []struct {
+		name      string
+		formatter fakedata.Formatter
+		want      string
+	}{
+		{"column", &fakedata.ColumnFormatter{Separator: "\t"}, "true\t0\tfalse"},
+		{"sql", &fakedata.SQLFormatter{Table: "USERS"}, "INSERT INTO USERS (active,admin,flag) VALUES (TRUE,'0','false');"},
+		{"ndjson", &fakedata.NdjsonFormatter{}, `{"active":true,"admin":false,"flag":"false"}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.formatter.Format(columns, values); got != tt.want {
+				t.Errorf("Format() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func BenchmarkFormatters(b *testing.B) {
 	column := &fakedata.ColumnFormatter{}
 	b.Run("ColumnFormatter", func(b *testing.B) {
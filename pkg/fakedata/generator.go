@@ -17,9 +17,12 @@ import (
 type Generator struct {
 	Func       func() string
 	CustomFunc func(string) (func() string, error)
-	Desc       string
-	Name       string
-	Hidden     bool
+	// NewRow, when not nil, tells the generator that a new row starts. The
+	// funcs of csv.row return values of the same record until then
+	NewRow func()
+	Desc   string
+	Name   string
+	Hidden bool
 }
 
 // Generators is an array of Generator
@@ -32,8 +35,14 @@ func (g Generator) IsCustom() bool {
 }
 
 // NewGenerators returns the available generators
-func NewGenerators() (gens Generators) {
-	f := newFactory()
+func NewGenerators() Generators {
+	return NewLocalizedGenerators(data.Locale{})
+}
+
+// NewLocalizedGenerators returns the available generators using the datasets
+// of locale
+func NewLocalizedGenerators(locale data.Locale) (gens Generators) {
+	f := newFactory(locale, newRand(0))
 
 	for _, gen := range f.generators {
 		gens = append(gens, gen)
@@ -73,60 +82,66 @@ func (gens Generators) FindByName(name string) (gen *Generator) {
 	return gen
 }
 
-func withList(list []string) func() string {
+// oneOf returns a random item of list
+func (f factory) oneOf(list []string) string {
+	return list[f.rand.Intn(len(list))]
+}
+
+func (f factory) withList(list []string) func() string {
 	return func() string {
-		return list[rand.Intn(len(list))]
+		return f.oneOf(list)
 	}
 }
 
-func withMapValues(m map[string]string) func() string {
+// withMapValues sorts the values of m so that the same seed picks the same
+// values, whatever the order of the map
+func (f factory) withMapValues(m map[string]string) func() string {
 	values := make([]string, len(m))
 	i := 0
 	for _, v := range m {
 		values[i] = v
 		i++
 	}
+	sort.Strings(values)
 
-	return withList(values)
+	return f.withList(values)
 }
 
-var tdl = withList(data.TLDs)
-
-var host = withList([]string{"test", "example"})
-
-var username = withList(data.Usernames)
-
-var phoneCode = withMapValues(data.CountryCodes)
-
-func ipv4() string {
-	return fmt.Sprintf("%d.%d.%d.%d", 1+rand.Intn(253), rand.Intn(255), rand.Intn(255), 1+rand.Intn(253))
-}
+// withWeightedList returns values with a probability proportional to their
+// weights
+func (f factory) withWeightedList(values []string, weights []int) func() string {
+	cumulative := make([]int, len(weights))
+	total := 0
+	for i, w := range weights {
+		total += w
+		cumulative[i] = total
+	}
 
-func ipv6() string {
-	return fmt.Sprintf("2001:cafe:%x:%x:%x:%x:%x:%x", rand.Intn(255), rand.Intn(255), rand.Intn(255), rand.Intn(255), rand.Intn(255), rand.Intn(255))
+	return func() string {
+		n := f.rand.Intn(total)
+		return values[sort.SearchInts(cumulative, n+1)]
+	}
 }
 
-func mac() string {
-	return fmt.Sprintf("%X:%X:%X:%X:%X:%X", rand.Intn(255), rand.Intn(255), rand.Intn(255), rand.Intn(255), rand.Intn(255), rand.Intn(255))
-}
+var hosts = []string{"test", "example"}
 
-func latitude() string {
-	return strconv.FormatFloat((rand.Float64()*180)-90, 'f', 6, 64)
+func (f factory) latitude() string {
+	return strconv.FormatFloat((f.rand.Float64()*180)-90, 'f', 6, 64)
 }
 
-func longitude() string {
-	return strconv.FormatFloat((rand.Float64()*360)-180, 'f', 6, 64)
+func (f factory) longitude() string {
+	return strconv.FormatFloat((f.rand.Float64()*360)-180, 'f', 6, 64)
 }
 
-func double() string {
-	return strconv.FormatFloat(rand.NormFloat64()*1000, 'f', 4, 64)
+func (f factory) double() string {
+	return strconv.FormatFloat(f.rand.NormFloat64()*1000, 'f', 4, 64)
 }
 
-func domain() string {
-	return host() + "." + tdl()
+func (f factory) domain() string {
+	return f.oneOf(hosts) + "." + f.oneOf(data.TLDs)
 }
 
-func date(options string) (f func() string, err error) {
+func (f factory) date(options string) (fn func() string, err error) {
 	var min, max string
 
 	endDate := time.Now()
@@ -166,11 +181,11 @@ func date(options string) (f func() string, err error) {
 	}
 
 	return func() string {
-		return startDate.Add(time.Duration(rand.Intn(int(endDate.Sub(startDate))))).Format("2006-01-02")
+		return startDate.Add(time.Duration(f.rand.Intn(int(endDate.Sub(startDate))))).Format("2006-01-02")
 	}, err
 }
 
-func integer(options string) (func() string, error) {
+func (f factory) integer(options string) (func() string, error) {
 	min := 0
 	max := 1000
 	var low, high string
@@ -203,10 +218,16 @@ func integer(options string) (func() string, error) {
 		return nil, fmt.Errorf("max(%d) is smaller than min(%d)", max, min)
 	}
 
-	return func() string { return strconv.Itoa(min + rand.Intn(max+1-min)) }, nil
+	return func() string { return strconv.Itoa(min + f.rand.Intn(max+1-min)) }, nil
 }
 
-func file(path string) (func() string, error) {
+// file returns random lines of a file. With weighted, each line is a value and
+// an integer weight separated by a tab, and values come up proportionally to
+// their weights (example: names.txt,weighted)
+func (f factory) file(options string) (func() string, error) {
+	path := strings.TrimSuffix(options, ",weighted")
+	weighted := path != options
+
 	if path == "" {
 		return nil, fmt.Errorf("no file path given")
 	}
@@ -219,22 +240,47 @@ func file(path string) (func() string, error) {
 	}
 
 	content := strings.Split(strings.Trim(string(file), "\n"), "\n")
-	list := withList(content)
+	if !weighted {
+		return f.withList(content), nil
+	}
+
+	values := make([]string, len(content))
+	weights := make([]int, len(content))
+	total := 0
+	for i, line := range content {
+		tab := strings.LastIndex(line, "\t")
+		if tab < 0 {
+			return nil, fmt.Errorf("line %d of %s has no weight: %s", i+1, filePath, line)
+		}
+
+		w, err := strconv.Atoi(strings.TrimSpace(line[tab+1:]))
+		if err != nil || w < 0 {
+			return nil, fmt.Errorf("line %d of %s has an invalid weight: %s", i+1, filePath, line[tab+1:])
+		}
+
+		values[i] = line[:tab]
+		weights[i] = w
+		total += w
+	}
+
+	if total == 0 {
+		return nil, fmt.Errorf("all weights of %s are zero", filePath)
+	}
 
-	return func() string { return list() }, nil
+	return f.withWeightedList(values, weights), nil
 }
 
-func enum(options string) (func() string, error) {
+func (f factory) enum(options string) (func() string, error) {
 	list := []string{"foo", "bar", "baz"}
 	if options != "" {
 		list = strings.Split(options, ",")
 	}
-	return withList(list), nil
+	return f.withList(list), nil
 }
 
-func localPhone(options string) (func() string, error) {
+func (f factory) localPhone(options string) (func() string, error) {
 	if len(options) == 0 {
-		return integer("10000000,99999999")
+		return f.integer("10000000,99999999")
 	}
 	numDigits, err := strconv.Atoi(options)
 	if err != nil {
@@ -243,24 +289,24 @@ func localPhone(options string) (func() string, error) {
 
 	switch numDigits {
 	case 8:
-		return integer("10000000,99999999")
+		return f.integer("10000000,99999999")
 	case 9:
-		return integer("100000000,999999999")
+		return f.integer("100000000,999999999")
 	case 10:
-		return integer("1000000000,9999999999")
+		return f.integer("1000000000,9999999999")
 	case 11:
-		return integer("10000000000,99999999999")
+		return f.integer("10000000000,99999999999")
 	case 12:
-		return integer("100000000000,999999999999")
+		return f.integer("100000000000,999999999999")
 	default:
 		return nil, fmt.Errorf("digits must be >=8 and <=12")
 	}
 }
 
-func timestamp() func() string {
+func (f factory) timestamp() func() string {
 	now := time.Now()
 	return func() string {
-		return fmt.Sprintf("%d", rand.Int63n(now.Unix()))
+		return fmt.Sprintf("%d", f.rand.Int63n(now.Unix()))
 	}
 }
 
@@ -300,25 +346,6 @@ func uuidv7() string {
 	return u7.String()
 }
 
-func phoneGenerator(phoneCodeFunc func() string) func() string {
-	var localPhone, _ = integer("10000000,9999999999")
-	return func() string {
-		number := "+" + phoneCodeFunc() + localPhone()
-		if len(number) > 15 {
-			number = number[0:14]
-		}
-		return number
-	}
-}
-
-func phone() string {
-	return phoneGenerator(phoneCode)()
-}
-
-func countryPhone(countryCode string) func() string {
-	return phoneGenerator(func() string { return data.CountryCodes[countryCode] })
-}
-
 type generatorsMap map[string]Generator
 
 func (gM generatorsMap) addGen(g Generator) {
@@ -327,6 +354,16 @@ func (gM generatorsMap) addGen(g Generator) {
 
 type factory struct {
 	generators generatorsMap
+	csvRows    map[string]*csvRow
+	rand       *rand.Rand
+}
+
+// resetRows makes csv.row draw new records, callers invoke it when a new row
+// starts
+func (f factory) resetRows() {
+	for _, row := range f.csvRows {
+		row.record = nil
+	}
 }
 
 func (f factory) extractFunc(key, options string) (fn func() string, err error) {
@@ -342,68 +379,136 @@ func (f factory) extractFunc(key, options string) (fn func() string, err error)
 	return gen.Func, nil
 }
 
-func newFactory() (f factory) {
+// newRand returns the random source of a factory. The same seed generates the
+// same values, 0 seeds it from the current time
+func newRand(seed int64) *rand.Rand {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+
+	return rand.New(rand.NewSource(seed))
+}
+
+// newFactory returns the generators of locale. They draw from r, so that the
+// same seed generates the same values
+func newFactory(locale data.Locale, r *rand.Rand) factory {
 	generators := make(generatorsMap)
+	f := factory{generators: generators, csvRows: make(map[string]*csvRow), rand: r}
 
 	generators.addGen(Generator{
 		Name: "domain.tld",
 		Desc: "valid TLD name from https://data.iana.org/TLD/tlds-alpha-by-domain.txt",
-		Func: tdl,
+		Func: f.withList(data.TLDs),
 	})
 
-	countryCodes := make([]string, len(data.CountryCodes))
-	i := 0
+	countryCodes := make([]string, 0, len(data.CountryCodes))
 	for k := range data.CountryCodes {
-		countryCodes[i] = k
-		i++
+		countryCodes = append(countryCodes, k)
+	}
+	sort.Strings(countryCodes)
+
+	for _, k := range countryCodes {
 		generators.addGen(Generator{
-			Name:   "phone." + strings.ToLower(k),
-			Desc:   k + " phone number",
-			Func:   countryPhone(k),
-			Hidden: true,
+			Name:       "phone." + strings.ToLower(k),
+			Desc:       k + " phone number. It accepts a format: e164 (default), national or international",
+			Func:       f.phoneNumber(k, e164),
+			CustomFunc: f.countryPhone(k),
+			Hidden:     true,
 		})
 	}
 
-	generators.addGen(Generator{Name: "country", Desc: "Full country name", Func: withList(data.Countries)})
-	generators.addGen(Generator{Name: "country.code", Desc: "2-digit country code", Func: withList(countryCodes)})
+	generators.addGen(Generator{Name: "country", Desc: "Full country name", Func: f.withList(data.Countries)})
+	generators.addGen(Generator{Name: "country.code", Desc: "2-digit country code", Func: f.withList(countryCodes)})
 
-	generators.addGen(Generator{Name: "phone", Desc: "Phone number according to E.164", Func: phone})
-	generators.addGen(Generator{Name: "phone.code", Desc: "Calling country code", Func: phoneCode})
+	phoneFunc := f.phone(countryCodes)
+	if locale.Country != "" {
+		phoneFunc = f.phoneNumber(locale.Country, e164)
+	}
+	generators.addGen(Generator{Name: "phone", Desc: "Phone number according to E.164, of the country of the locale with --locale", Func: phoneFunc})
+	generators.addGen(Generator{Name: "phone.code", Desc: "Calling country code", Func: f.withMapValues(data.CountryCodes)})
 
-	generators.addGen(Generator{Name: "state", Desc: "Full US state name", Func: withList(data.States)})
+	generators.addGen(Generator{Name: "state", Desc: "Full US state name", Func: f.withList(data.States)})
 
-	generators.addGen(Generator{Name: "state.code", Desc: "2-digit US state name", Func: withList(data.StateCodes)})
+	generators.addGen(Generator{Name: "state.code", Desc: "2-digit US state name", Func: f.withList(data.StateCodes)})
 
-	generators.addGen(Generator{Name: "timezone", Desc: "tz in the form Area/City", Func: withList(data.Timezones)})
+	generators.addGen(Generator{Name: "timezone", Desc: "tz in the form Area/City", Func: f.withList(data.Timezones)})
 
-	generators.addGen(Generator{Name: "username", Desc: `username using the pattern \w+`, Func: username})
+	generators.addGen(Generator{Name: "username", Desc: `username using the pattern \w+`, Func: f.withList(data.Usernames)})
 
-	generators.addGen(Generator{Name: "nationality", Desc: "nationality", Func: withList(data.Nationalities)})
+	generators.addGen(Generator{Name: "nationality", Desc: "nationality", Func: f.withList(data.Nationalities)})
 
-	firstNames := withList(data.Firstnames)
+	firstNames := f.withList(localized(locale.Firstnames, data.Firstnames))
 	generators.addGen(Generator{Name: "name.first", Desc: "capitalized first name", Func: firstNames})
 
-	lastNames := withList(data.Lastnames)
+	lastNames := f.withList(localized(locale.Lastnames, data.Lastnames))
 	generators.addGen(Generator{Name: "name.last", Desc: "capitalized last name", Func: lastNames})
 
-	generators.addGen(Generator{Name: "color", Desc: "one word color", Func: withList(data.Colors)})
+	generators.addGen(Generator{Name: "color", Desc: "one word color", Func: f.withList(data.Colors)})
 
 	generators.addGen(Generator{
 		Name: "event.action",
 		Desc: `clicked|purchased|viewed|watched`,
-		Func: withList([]string{"clicked", "purchased", "viewed", "watched"}),
+		Func: f.withList([]string{"clicked", "purchased", "viewed", "watched"}),
 	})
 
 	generators.addGen(Generator{
 		Name: "http.method",
-		Desc: `DELETE|GET|HEAD|OPTION|PATCH|POST|PUT`,
-		Func: withList([]string{"DELETE", "GET", "HEAD", "OPTION", "PATCH", "POST", "PUT"}),
+		Desc: `DELETE|GET|HEAD|OPTIONS|PATCH|POST|PUT`,
+		Func: f.withList([]string{"DELETE", "GET", "HEAD", "OPTIONS", "PATCH", "POST", "PUT"}),
+	})
+
+	defaultHTTPStatus, _ := f.httpStatus("")
+	generators.addGen(Generator{
+		Name:       "http.status",
+		Desc:       "HTTP status code, mostly 2xx. It accepts a comma-separated list of classes or codes (example: 4xx,5xx)",
+		Func:       defaultHTTPStatus,
+		CustomFunc: f.httpStatus,
+	})
+
+	defaultUserAgent, _ := f.userAgent("")
+	generators.addGen(Generator{
+		Name:       "http.useragent",
+		Desc:       "browser user agent. It accepts a device: bot, desktop or mobile",
+		Func:       defaultUserAgent,
+		CustomFunc: f.userAgent,
+	})
+
+	defaultHTTPHeader, _ := f.httpHeader("")
+	generators.addGen(Generator{
+		Name:       "http.header",
+		Desc:       "HTTP header in the form Name: value. It accepts a header name to generate only its values",
+		Func:       defaultHTTPHeader,
+		CustomFunc: f.httpHeader,
+	})
+
+	defaultMimeType, _ := f.mimeType("")
+	generators.addGen(Generator{
+		Name:       "mime.type",
+		Desc:       "media type. It accepts a top-level type (example: image)",
+		Func:       defaultMimeType,
+		CustomFunc: f.mimeType,
+	})
+
+	generators.addGen(Generator{
+		Name: "log.apache",
+		Desc: "Apache access log line in the combined log format",
+		Func: f.accessLog("-"),
+	})
+
+	generators.addGen(Generator{
+		Name: "log.nginx",
+		Desc: "nginx access log line in the combined log format",
+		Func: f.accessLog("0"),
 	})
 
 	generators.addGen(Generator{
 		Name: "name",
-		Desc: `name.first + " " + name.last`,
+		Desc: `name.first + " " + name.last, or the other way around for locales that put the family name first`,
 		Func: func() string {
+			if locale.FamilyNameFirst {
+				return lastNames() + " " + firstNames()
+			}
+
 			return firstNames() + " " + lastNames()
 		},
 	})
@@ -412,83 +517,268 @@ func newFactory() (f factory) {
 		Name: "email",
 		Desc: "email",
 		Func: func() string {
-			return username() + "@" + domain()
+			return f.oneOf(data.Usernames) + "@" + f.domain()
 		},
 	})
 
-	generators.addGen(Generator{Name: "domain", Desc: "domain", Func: domain})
+	generators.addGen(Generator{Name: "domain", Desc: "domain", Func: f.domain})
 
-	generators.addGen(Generator{Name: "ipv4", Desc: "ipv4", Func: ipv4})
+	defaultIPv4, _ := f.ipv4("")
+	generators.addGen(Generator{
+		Name:       "ipv4",
+		Desc:       "ipv4. By default, it generates public addresses. It accepts private, public, reserved or a CIDR (example: 10.0.0.0/8)",
+		Func:       defaultIPv4,
+		CustomFunc: f.ipv4,
+	})
 
-	generators.addGen(Generator{Name: "ipv6", Desc: "ipv6", Func: ipv6})
+	defaultIPv6, _ := f.ipv6("")
+	generators.addGen(Generator{
+		Name:       "ipv6",
+		Desc:       "ipv6. By default, it generates public addresses. It accepts private, public, reserved or a CIDR (example: fd00::/8)",
+		Func:       defaultIPv6,
+		CustomFunc: f.ipv6,
+	})
 
-	generators.addGen(Generator{Name: "mac.address", Desc: "mac address", Func: mac})
+	defaultMac, _ := f.mac("")
+	generators.addGen(Generator{
+		Name:       "mac.address",
+		Desc:       "mac address. It accepts local for locally administered addresses or an OUI (example: 00:1A:2B)",
+		Func:       defaultMac,
+		CustomFunc: f.mac,
+	})
 
-	generators.addGen(Generator{Name: "latitude", Desc: "latitude", Func: latitude})
+	defaultCIDR, _ := f.cidr("")
+	generators.addGen(Generator{
+		Name:       "cidr",
+		Desc:       "network in CIDR notation. It accepts an address family: ipv4 (default) or ipv6",
+		Func:       defaultCIDR,
+		CustomFunc: f.cidr,
+	})
+
+	defaultPort, _ := f.port("")
+	generators.addGen(Generator{
+		Name:       "port",
+		Desc:       "port number. It accepts well-known, registered, dynamic or a range (example: 8000,9000)",
+		Func:       defaultPort,
+		CustomFunc: f.port,
+	})
+
+	generators.addGen(Generator{Name: "hostname", Desc: "hostname in the form role-NN.domain", Func: f.hostname})
+
+	defaultURL, _ := f.webURL("")
+	generators.addGen(Generator{
+		Name:       "url",
+		Desc:       "URL with optional port, query and fragment. It accepts a scheme and a path depth (example: https,2)",
+		Func:       defaultURL,
+		CustomFunc: f.webURL,
+	})
+
+	defaultURLPath, _ := f.urlPath("")
+	generators.addGen(Generator{
+		Name:       "url.path",
+		Desc:       "URL path. It accepts a path depth",
+		Func:       defaultURLPath,
+		CustomFunc: f.urlPath,
+	})
+
+	defaultURLQuery, _ := f.urlQuery("")
+	generators.addGen(Generator{
+		Name:       "url.query",
+		Desc:       "URL query string. It accepts a number of parameters",
+		Func:       defaultURLQuery,
+		CustomFunc: f.urlQuery,
+	})
 
-	generators.addGen(Generator{Name: "longitude", Desc: "longitude", Func: longitude})
+	generators.addGen(Generator{Name: "latitude", Desc: "latitude", Func: f.latitude})
 
-	generators.addGen(Generator{Name: "double", Desc: "double number", Func: double})
+	generators.addGen(Generator{Name: "longitude", Desc: "longitude", Func: f.longitude})
+
+	generators.addGen(Generator{Name: "double", Desc: "double number", Func: f.double})
+
+	generators.addGen(Generator{Name: "currency.code", Desc: "ISO 4217 currency code", Func: f.currencyCode})
+
+	defaultCurrencySymbol, _ := f.currencySymbol("")
+	generators.addGen(Generator{
+		Name:       "currency.symbol",
+		Desc:       "currency symbol. It accepts a currency code (example: EUR)",
+		Func:       defaultCurrencySymbol,
+		CustomFunc: f.currencySymbol,
+	})
+
+	defaultMoney, _ := f.money("")
+	generators.addGen(Generator{
+		Name:       "money",
+		Desc:       "amount with the decimals of a currency. It accepts min,max,currency, by default 1,1000,USD (example: 10,500,JPY)",
+		Func:       defaultMoney,
+		CustomFunc: f.money,
+	})
+
+	defaultBoolean, _ := f.boolean("")
+	generators.addGen(Generator{
+		Name:       "bool",
+		Desc:       "boolean. It accepts the probability of true and a style: true (default), 1 or t (example: 0.3,t)",
+		Func:       defaultBoolean,
+		CustomFunc: f.boolean,
+	})
 
 	generators.addGen(Generator{
 		Name: "noun",
 		Desc: "noun from https://github.com/dariusk/corpora/blob/master/data/words/nouns.json",
-		Func: withList(data.Nouns),
+		Func: f.withList(data.Nouns),
 	})
 
 	generators.addGen(Generator{
 		Name: "emoji",
 		Desc: "emoji from https://github.com/dariusk/corpora/blob/master/data/words/emojis.json",
-		Func: withList(data.Emoji),
+		Func: f.withList(data.Emoji),
 	})
 
-	generators.addGen(Generator{Name: "adjectives", Desc: "adjective", Func: withList(data.Adjectives)})
+	generators.addGen(Generator{Name: "adjectives", Desc: "adjective", Func: f.withList(data.Adjectives)})
+
+	generators.addGen(Generator{Name: "animal", Desc: "animal breed", Func: f.withList(data.Animals)})
+
+	generators.addGen(Generator{Name: "animal.cat", Desc: "random cat breed", Func: f.withList(data.Cats)})
+
+	generators.addGen(Generator{Name: "animal.dog", Desc: "dog breed", Func: f.withList(data.Dogs)})
+
+	generators.addGen(Generator{Name: "city", Desc: "city name. US by default", Func: f.withList(localized(locale.Cities, data.Cities))})
+
+	generators.addGen(Generator{Name: "street", Desc: "street name. US by default", Func: f.withList(localized(locale.Streets, data.Streets))})
+
+	generators.addGen(Generator{Name: "dinosaur", Desc: "Dinosaur name", Func: f.withList(data.Dinosaurs)})
+
+	generators.addGen(Generator{Name: "industry", Desc: "industry", Func: f.withList(data.Industries)})
+
+	generators.addGen(Generator{Name: "occupation", Desc: "occupation", Func: f.withList(data.Occupations)})
+
+	generators.addGen(Generator{Name: "job.title", Desc: "job title", Func: f.jobTitle})
+
+	generators.addGen(Generator{Name: "company.name", Desc: "company name", Func: f.companyName(lastNames)})
 
-	generators.addGen(Generator{Name: "animal", Desc: "animal breed", Func: withList(data.Animals)})
+	generators.addGen(Generator{Name: "company.suffix", Desc: "company suffix", Func: f.companySuffix})
 
-	generators.addGen(Generator{Name: "animal.cat", Desc: "random cat breed", Func: withList(data.Cats)})
+	generators.addGen(Generator{Name: "product.category", Desc: "product category", Func: f.withList(data.ProductCategories)})
 
-	generators.addGen(Generator{Name: "animal.dog", Desc: "dog breed", Func: withList(data.Dogs)})
+	generators.addGen(Generator{Name: "product.name", Desc: "made-up product name", Func: f.productName})
 
-	generators.addGen(Generator{Name: "city", Desc: "US city name", Func: withList(data.Cities)})
+	defaultProductSKU, _ := f.productSKU("")
+	generators.addGen(Generator{
+		Name:       "product.sku",
+		Desc:       `product SKU. It accepts a pattern where # is a digit and A a letter, \ makes them literal, AAA-##### by default (example: \ABC-###)`,
+		Func:       defaultProductSKU,
+		CustomFunc: f.productSKU,
+	})
 
-	generators.addGen(Generator{Name: "dinosaur", Desc: "Dinosaur name", Func: withList(data.Dinosaurs)})
+	generators.addGen(Generator{Name: "sentence", Desc: "sentence", Func: f.withList(data.Sentences)})
 
-	generators.addGen(Generator{Name: "industry", Desc: "industry", Func: withList(data.Industries)})
+	defaultWords, _ := f.words("")
+	generators.addGen(Generator{
+		Name:       "words",
+		Desc:       "words. It accepts a count or a min,max range, and lorem for lorem ipsum (example: 5,lorem)",
+		Func:       defaultWords,
+		CustomFunc: f.words,
+	})
 
-	generators.addGen(Generator{Name: "occupation", Desc: "occupation", Func: withList(data.Occupations)})
+	defaultParagraph, _ := f.paragraph("")
+	generators.addGen(Generator{
+		Name:       "paragraph",
+		Desc:       "paragraph. It accepts a number of sentences or a min,max range, and lorem for lorem ipsum",
+		Func:       defaultParagraph,
+		CustomFunc: f.paragraph,
+	})
 
-	generators.addGen(Generator{Name: "sentence", Desc: "sentence", Func: withList(data.Sentences)})
+	defaultText, _ := f.text("")
+	generators.addGen(Generator{
+		Name:       "text",
+		Desc:       "text truncated on a word boundary. It accepts a maximum number of characters, and lorem for lorem ipsum",
+		Func:       defaultText,
+		CustomFunc: f.text,
+	})
 
 	// custom generators
 	generators.addGen(Generator{
 		Name:       "date",
 		Desc:       `random date in the format YYYY-MM-DD. By default, it generates dates in the last year`,
-		CustomFunc: date,
+		CustomFunc: f.date,
 	})
 
 	generators.addGen(Generator{
 		Name:       "int",
 		Desc:       "positive integer between 1 and 1000",
-		CustomFunc: integer,
+		CustomFunc: f.integer,
 	})
 
 	generators.addGen(Generator{
 		Name:       "enum",
 		Desc:       `value from an enum. By default, the enum is foo,bar,baz. It accepts a list of comma-separated values`,
-		CustomFunc: enum,
+		CustomFunc: f.enum,
 	})
 
 	generators.addGen(Generator{
 		Name:       "file",
-		Desc:       `random value from a file. It accepts a file path. It can be either relative or absolute. The file must contain a value per line`,
-		CustomFunc: file,
+		Desc:       `random value from a file. It accepts a file path. It can be either relative or absolute. The file must contain a value per line. With weighted, each value is followed by a tab and an integer weight (example: names.txt,weighted)`,
+		CustomFunc: f.file,
+	})
+
+	generators.addGen(Generator{
+		Name:       "csv",
+		Desc:       `random value from a column of a CSV file with a header. It accepts a file path and a column name or position (example: products.csv,name)`,
+		CustomFunc: f.csvColumn,
+	})
+
+	generators.addGen(Generator{
+		Name:       "csv.row",
+		Desc:       `value from a column of a CSV file with a header. Columns of the same file in a row come from the same record (example: products.csv,sku)`,
+		CustomFunc: f.csvRow,
+		NewRow:     f.resetRows,
+	})
+
+	generators.addGen(Generator{
+		Name:       "markov",
+		Desc:       `sentence from a Markov chain trained on a file. It accepts a file path and an optional order, 2 by default (example: reviews.txt,3)`,
+		CustomFunc: f.markov,
 	})
 
 	generators.addGen(Generator{
 		Name:       "phone.local",
 		Desc:       "phone number without calling country code. It accepts an integer N number of digits. Min: 8, Max: 12",
-		CustomFunc: localPhone,
+		CustomFunc: f.localPhone,
+	})
+
+	generators.addGen(Generator{
+		Name:       "creditcard",
+		Desc:       "Luhn-valid credit card number. It accepts a network: amex, mastercard or visa",
+		CustomFunc: f.creditCard,
+	})
+
+	generators.addGen(Generator{
+		Name:       "iban",
+		Desc:       "IBAN with valid check digits. It accepts a 2-digit country code (example: DE)",
+		CustomFunc: f.iban,
+	})
+
+	generators.addGen(Generator{
+		Name:       "isbn10",
+		Desc:       "ISBN-10 with valid check digit. It accepts a prefix of digits",
+		CustomFunc: f.isbn10,
+	})
+
+	generators.addGen(Generator{
+		Name:       "isbn13",
+		Desc:       "ISBN-13 with valid check digit. By default, it starts with 978 or 979. It accepts a prefix of digits",
+		CustomFunc: f.isbn13,
+	})
+
+	generators.addGen(Generator{
+		Name:       "ean13",
+		Desc:       "EAN-13 barcode with valid check digit. It accepts a prefix of digits",
+		CustomFunc: f.ean13,
+	})
+
+	generators.addGen(Generator{
+		Name:       "upc",
+		Desc:       "UPC-A barcode with valid check digit. It accepts a prefix of digits",
+		CustomFunc: f.upc,
 	})
 
 	generators.addGen(Generator{Name: "uuidv1", Desc: "uuidv1", Func: uuidv1})
@@ -499,8 +789,8 @@ func newFactory() (f factory) {
 	generators.addGen(Generator{
 		Name: "timestamp",
 		Desc: "Unix timestamp between epoch and now",
-		Func: timestamp(),
+		Func: f.timestamp(),
 	})
 
-	return factory{generators: generators}
+	return f
 }
@@ -12,7 +12,7 @@ func BenchmarkGenerators(b *testing.B) {
 	for i := 0; i < len(gens); i++ {
 		g := gens[i]
 
-		if !g.IsCustom() && !g.Hidden {
+		if g.Func != nil && !g.Hidden {
 			b.Run(g.Name, func(b *testing.B) {
 				for i := 0; i < b.N; i++ {
 					g.Func()
@@ -80,3 +80,30 @@ func BenchmarkFile(b *testing.B) {
 		fileFunc()
 	}
 }
+
+func TestWeightedFile(t *testing.T) {
+	fn, err := gens.FindByName("file").CustomFunc("../../testutil/fixtures/weighted.txt,weighted")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	counts := make(map[string]int)
+	for i := 0; i < 10000; i++ {
+		counts[fn()]++
+	}
+
+	if counts["Zelda"] != 0 {
+		t.Errorf("expected values with zero weight to never come up, but Zelda did %d times", counts["Zelda"])
+	}
+
+	if counts["Olivia"] <= counts["Amelia"] || counts["Amelia"] == 0 {
+		t.Errorf("expected Olivia to come up more often than Amelia, but got %v", counts)
+	}
+}
+
+func TestWeightedFileWithInvalidWeights(t *testing.T) {
+	_, err := gens.FindByName("file").CustomFunc("../../testutil/fixtures/file.txt,weighted")
+	if err == nil {
+		t.Error("expected an error for lines without weights, but got none")
+	}
+}
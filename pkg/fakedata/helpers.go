@@ -0,0 +1,425 @@
+package fakedata
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/language"
+	"golang.org/x/text/unicode/norm"
+)
+
+// stringHelpers returns the template functions that manipulate strings. The
+// string to manipulate is always the last argument so that helpers work in
+// pipelines (example: {{ Name | Replace " " "_" | Lower }})
+func stringHelpers() template.FuncMap {
+	title := cases.Title(language.English)
+
+	return template.FuncMap{
+		"Lower":      strings.ToLower,
+		"Upper":      strings.ToUpper,
+		"Title":      func(s string) string { return title.String(s) },
+		"Slugify":    slugify,
+		"Trim":       strings.TrimSpace,
+		"Replace":    func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+		"Substr":     substr,
+		"PadLeft":    func(width int, pad, s string) string { return padding(width, pad, s) + s },
+		"PadRight":   func(width int, pad, s string) string { return s + padding(width, pad, s) },
+		"Join":       func(sep string, list []string) string { return strings.Join(list, sep) },
+		"Split":      func(sep, s string) []string { return strings.Split(s, sep) },
+		"EscapeJSON": escapeJSON,
+		"EscapeSQL":  func(s string) string { return strings.ReplaceAll(s, "'", "''") },
+		"EscapeCSV":  escapeCSV,
+	}
+}
+
+// slugify lowercases s, strips accents and replaces everything but letters and
+// digits with dashes (example: Crème Brûlée becomes creme-brulee)
+func slugify(s string) string {
+	var b strings.Builder
+	dash := false
+	// decomposing separates accents from the letters they go on
+	for _, r := range norm.NFD.String(strings.ToLower(s)) {
+		if unicode.Is(unicode.Mn, r) {
+			continue
+		}
+
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			if dash && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			dash = false
+		} else {
+			dash = true
+		}
+	}
+
+	return b.String()
+}
+
+// substr returns length characters of s from start. It never panics, start and
+// length are clamped to s instead
+func substr(start, length int, s string) string {
+	r := []rune(s)
+	if start < 0 {
+		start = 0
+	}
+
+	if start > len(r) {
+		start = len(r)
+	}
+
+	end := start + length
+	if length < 0 || end > len(r) {
+		end = len(r)
+	}
+
+	return string(r[start:end])
+}
+
+// padding returns enough repetitions of pad to make s width characters long
+func padding(width int, pad, s string) string {
+	n := width - utf8.RuneCountInString(s)
+	if n <= 0 || pad == "" {
+		return ""
+	}
+
+	p := []rune(strings.Repeat(pad, n))
+	return string(p[:n])
+}
+
+// escapeJSON escapes s so that it can go between double quotes in JSON
+func escapeJSON(s string) string {
+	var b strings.Builder
+	enc := json.NewEncoder(&b)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s)
+
+	quoted := strings.TrimSuffix(b.String(), "\n")
+	return quoted[1 : len(quoted)-1]
+}
+
+// escapeCSV quotes s when it contains separators, quotes or new lines
+func escapeCSV(s string) string {
+	if !strings.ContainsAny(s, ",\"\r\n") {
+		return s
+	}
+
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// mathHelpers returns the template functions for arithmetic and comparisons.
+// They take numbers or strings that contain numbers, like the ones generators
+// return. Results are integers when all the operands are
+func mathHelpers() template.FuncMap {
+	return template.FuncMap{
+		"Add": func(a, b interface{}) (interface{}, error) {
+			return arithmetic(a, b, func(x, y int) int { return x + y }, func(x, y float64) float64 { return x + y })
+		},
+		"Sub": func(a, b interface{}) (interface{}, error) {
+			return arithmetic(a, b, func(x, y int) int { return x - y }, func(x, y float64) float64 { return x - y })
+		},
+		"Mul": func(a, b interface{}) (interface{}, error) {
+			return arithmetic(a, b, func(x, y int) int { return x * y }, func(x, y float64) float64 { return x * y })
+		},
+		"Div": func(a, b interface{}) (interface{}, error) {
+			if y, err := toNumber(b); err == nil && y == 0 {
+				return nil, fmt.Errorf("division by zero")
+			}
+			return arithmetic(a, b, func(x, y int) int { return x / y }, func(x, y float64) float64 { return x / y })
+		},
+		"Mod": func(a, b interface{}) (int, error) {
+			x, errA := toInt(a)
+			y, errB := toInt(b)
+			if errA != nil || errB != nil {
+				return 0, fmt.Errorf("Mod takes integers, got %v and %v", a, b)
+			}
+
+			if y == 0 {
+				return 0, fmt.Errorf("division by zero")
+			}
+
+			return x % y, nil
+		},
+		"Min": func(a, b interface{}) (interface{}, error) {
+			return arithmetic(a, b, func(x, y int) int {
+				if x < y {
+					return x
+				}
+				return y
+			}, math.Min)
+		},
+		"Max": func(a, b interface{}) (interface{}, error) {
+			return arithmetic(a, b, func(x, y int) int {
+				if x > y {
+					return x
+				}
+				return y
+			}, math.Max)
+		},
+		"Lt": func(a, b interface{}) (bool, error) { return compare(a, b, func(c int) bool { return c < 0 }) },
+		"Le": func(a, b interface{}) (bool, error) { return compare(a, b, func(c int) bool { return c <= 0 }) },
+		"Gt": func(a, b interface{}) (bool, error) { return compare(a, b, func(c int) bool { return c > 0 }) },
+		"Ge": func(a, b interface{}) (bool, error) { return compare(a, b, func(c int) bool { return c >= 0 }) },
+	}
+}
+
+func toNumber(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), nil
+	case int64:
+		return float64(n), nil
+	case float64:
+		return n, nil
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		if err != nil {
+			return 0, fmt.Errorf("%s is not a number", n)
+		}
+		return f, nil
+	}
+
+	return 0, fmt.Errorf("%v is not a number", v)
+}
+
+func toInt(v interface{}) (int, error) {
+	switch n := v.(type) {
+	case int:
+		return n, nil
+	case int64:
+		return int(n), nil
+	case string:
+		return strconv.Atoi(strings.TrimSpace(n))
+	}
+
+	return 0, fmt.Errorf("%v is not an integer", v)
+}
+
+func arithmetic(a, b interface{}, ints func(int, int) int, floats func(float64, float64) float64) (interface{}, error) {
+	x, errA := toInt(a)
+	y, errB := toInt(b)
+	if errA == nil && errB == nil {
+		return ints(x, y), nil
+	}
+
+	f, err := toNumber(a)
+	if err != nil {
+		return nil, err
+	}
+
+	g, err := toNumber(b)
+	if err != nil {
+		return nil, err
+	}
+
+	return shortest(floats(f, g)), nil
+}
+
+// shortest rounds x to the 15 significant digits a float64 keeps of decimal
+// numbers, so that Add 0.1 0.2 is 0.3 instead of 0.30000000000000004
+func shortest(x float64) float64 {
+	rounded, err := strconv.ParseFloat(strconv.FormatFloat(x, 'g', 15, 64), 64)
+	if err != nil {
+		return x
+	}
+
+	return rounded
+}
+
+func compare(a, b interface{}, ok func(int) bool) (bool, error) {
+	x, err := toNumber(a)
+	if err != nil {
+		return false, err
+	}
+
+	y, err := toNumber(b)
+	if err != nil {
+		return false, err
+	}
+
+	switch {
+	case x < y:
+		return ok(-1), nil
+	case x > y:
+		return ok(1), nil
+	}
+
+	return ok(0), nil
+}
+
+// dateLayouts are the layouts ParseDate tries, the first is the one of the
+// date generator
+var dateLayouts = []string{"2006-01-02", time.RFC3339, "2006-01-02 15:04:05", "2006-01-02T15:04:05"}
+
+// dateHelpers returns the template functions for dates. They take times or
+// strings in one of dateLayouts, like the ones Date returns
+func dateHelpers() template.FuncMap {
+	return template.FuncMap{
+		"ParseDate": toTime,
+		"FormatDate": func(layout string, date interface{}) (string, error) {
+			t, err := toTime(date)
+			if err != nil {
+				return "", err
+			}
+			return t.Format(layout), nil
+		},
+		"DateAdd": dateAdd,
+		"Before": func(a, b interface{}) (bool, error) {
+			x, y, err := toTimes(a, b)
+			return x.Before(y), err
+		},
+		"After": func(a, b interface{}) (bool, error) {
+			x, y, err := toTimes(a, b)
+			return x.After(y), err
+		},
+	}
+}
+
+func toTime(v interface{}) (time.Time, error) {
+	switch d := v.(type) {
+	case time.Time:
+		return d, nil
+	case string:
+		for _, layout := range dateLayouts {
+			if t, err := time.Parse(layout, strings.TrimSpace(d)); err == nil {
+				return t, nil
+			}
+		}
+		return time.Time{}, fmt.Errorf("could not parse date %s. Dates must look like 2006-01-02 or 2006-01-02T15:04:05Z", d)
+	}
+
+	return time.Time{}, fmt.Errorf("%v is not a date", v)
+}
+
+func toTimes(a, b interface{}) (time.Time, time.Time, error) {
+	x, err := toTime(a)
+	if err != nil {
+		return x, x, err
+	}
+
+	y, err := toTime(b)
+	return x, y, err
+}
+
+// dateAdd adds a duration to date. Durations are the ones of Go (example: 36h
+// or -90m) plus days and weeks (example: 10d or 2w)
+func dateAdd(duration string, date interface{}) (time.Time, error) {
+	t, err := toTime(date)
+	if err != nil {
+		return t, err
+	}
+
+	days := 0
+	switch {
+	case strings.HasSuffix(duration, "d"):
+		days = 1
+	case strings.HasSuffix(duration, "w"):
+		days = 7
+	}
+
+	if days > 0 {
+		n, err := strconv.Atoi(duration[:len(duration)-1])
+		if err != nil {
+			return t, fmt.Errorf("could not parse duration %s. Durations look like 10d, 2w or 36h", duration)
+		}
+		return t.AddDate(0, 0, n*days), nil
+	}
+
+	d, err := time.ParseDuration(duration)
+	if err != nil {
+		return t, fmt.Errorf("could not parse duration %s. Durations look like 10d, 2w or 36h", duration)
+	}
+
+	return t.Add(d), nil
+}
+
+// listHelpers returns the template functions that pick from lists. Like the
+// string helpers, they take the list last so that they work in pipelines
+// (example: {{ Split "," "a,b,c" | Shuffle | Join "," }}). They draw from r
+func listHelpers(r *rand.Rand) template.FuncMap {
+	return template.FuncMap{
+		"List": func(items ...interface{}) []string { return toOptions(items) },
+		"Pick": func(list []string) (string, error) { return pick(r, list) },
+		"PickN": func(n interface{}, list []string) ([]string, error) {
+			return pickN(r, n, list)
+		},
+		"Shuffle": func(list []string) []string { return shuffle(r, list) },
+		"Sample": func(k interface{}, list []string) ([]string, error) {
+			return sample(r, k, list)
+		},
+	}
+}
+
+// pick returns a random item of list
+func pick(r *rand.Rand, list []string) (string, error) {
+	if len(list) == 0 {
+		return "", fmt.Errorf("cannot pick from an empty list")
+	}
+
+	return list[r.Intn(len(list))], nil
+}
+
+// pickN returns n distinct items of list in random order
+func pickN(r *rand.Rand, n interface{}, list []string) ([]string, error) {
+	indexes, err := randomIndexes(r, n, list)
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]string, len(indexes))
+	for i, index := range indexes {
+		items[i] = list[index]
+	}
+
+	return items, nil
+}
+
+// sample returns k distinct items of list in the order they have in list
+func sample(r *rand.Rand, k interface{}, list []string) ([]string, error) {
+	indexes, err := randomIndexes(r, k, list)
+	if err != nil {
+		return nil, err
+	}
+	sort.Ints(indexes)
+
+	items := make([]string, len(indexes))
+	for i, index := range indexes {
+		items[i] = list[index]
+	}
+
+	return items, nil
+}
+
+// shuffle returns a copy of list in random order
+func shuffle(r *rand.Rand, list []string) []string {
+	items := make([]string, len(list))
+	copy(items, list)
+	r.Shuffle(len(items), func(i, j int) { items[i], items[j] = items[j], items[i] })
+
+	return items
+}
+
+// randomIndexes returns n distinct random indexes of list
+func randomIndexes(r *rand.Rand, n interface{}, list []string) ([]int, error) {
+	count, err := toInt(n)
+	if err != nil {
+		return nil, err
+	}
+
+	if count < 0 || count > len(list) {
+		return nil, fmt.Errorf("cannot pick %d items from a list of %d", count, len(list))
+	}
+
+	return r.Perm(len(list))[:count], nil
+}
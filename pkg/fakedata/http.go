@@ -0,0 +1,130 @@
+package fakedata
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lucapette/fakedata/pkg/data"
+)
+
+// httpStatus returns status codes weighted by how common they are. It accepts
+// a comma-separated list of classes (example: 4xx) or codes
+func (f factory) httpStatus(options string) (func() string, error) {
+	var filters []string
+	if options != "" {
+		filters = strings.Split(options, ",")
+	}
+
+	for _, filter := range filters {
+		if len(filter) != 3 || filter[0] < '1' || filter[0] > '5' {
+			return nil, fmt.Errorf("%s is neither a status class (example: 4xx) nor a status code", filter)
+		}
+	}
+
+	var codes []string
+	var weights []int
+	for _, status := range data.HTTPStatuses {
+		matched := len(filters) == 0
+		for _, filter := range filters {
+			if filter == status.Code || (strings.HasSuffix(filter, "xx") && filter[0] == status.Code[0]) {
+				matched = true
+			}
+		}
+
+		if matched {
+			codes = append(codes, status.Code)
+			weights = append(weights, status.Weight)
+		}
+	}
+
+	if len(codes) == 0 {
+		return nil, fmt.Errorf("no status code matches %s", options)
+	}
+
+	return f.withWeightedList(codes, weights), nil
+}
+
+func (f factory) browserUserAgent(browsers []data.Browser) func() string {
+	return func() string {
+		b := browsers[f.rand.Intn(len(browsers))]
+		platform := b.Platforms[f.rand.Intn(len(b.Platforms))]
+		version := b.MinVersion + f.rand.Intn(b.MaxVersion+1-b.MinVersion)
+
+		return fmt.Sprintf(b.Template, platform, version)
+	}
+}
+
+func (f factory) userAgent(options string) (func() string, error) {
+	desktop := f.browserUserAgent(data.DesktopBrowsers)
+	mobile := f.browserUserAgent(data.MobileBrowsers)
+	bot := f.withList(data.Bots)
+
+	switch options {
+	case "":
+		device := f.withWeightedList([]string{"desktop", "mobile", "bot"}, []int{65, 30, 5})
+		return func() string {
+			switch device() {
+			case "desktop":
+				return desktop()
+			case "mobile":
+				return mobile()
+			default:
+				return bot()
+			}
+		}, nil
+	case "desktop":
+		return desktop, nil
+	case "mobile":
+		return mobile, nil
+	case "bot":
+		return bot, nil
+	default:
+		return nil, fmt.Errorf("unknown device: %s. Available devices: bot|desktop|mobile", options)
+	}
+}
+
+// httpHeader returns headers in the form Name: value. When options is a header
+// name, it returns only values of that header
+func (f factory) httpHeader(options string) (func() string, error) {
+	names := make([]string, 0, len(data.HTTPHeaders))
+	for name := range data.HTTPHeaders {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if options == "" {
+		return func() string {
+			name := names[f.rand.Intn(len(names))]
+			return name + ": " + f.withList(data.HTTPHeaders[name])()
+		}, nil
+	}
+
+	for _, name := range names {
+		if strings.EqualFold(name, options) {
+			return f.withList(data.HTTPHeaders[name]), nil
+		}
+	}
+
+	return nil, fmt.Errorf("unknown header: %s. Available headers: %s", options, strings.Join(names, ","))
+}
+
+// mimeType accepts a top-level type (example: image)
+func (f factory) mimeType(options string) (func() string, error) {
+	if options == "" {
+		return f.withList(data.MIMETypes), nil
+	}
+
+	var types []string
+	for _, t := range data.MIMETypes {
+		if strings.HasPrefix(t, options+"/") {
+			types = append(types, t)
+		}
+	}
+
+	if len(types) == 0 {
+		return nil, fmt.Errorf("unknown top-level type: %s", options)
+	}
+
+	return f.withList(types), nil
+}
@@ -0,0 +1,21 @@
+package fakedata_test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHTTPStatusIsMostly2xx(t *testing.T) {
+	fn := gens.FindByName("http.status").Func
+
+	successes := 0
+	for i := 0; i < 10000; i++ {
+		if strings.HasPrefix(fn(), "2") {
+			successes++
+		}
+	}
+
+	if successes < 5000 {
+		t.Errorf("expected most status codes to be 2xx, but got %d out of 10000", successes)
+	}
+}
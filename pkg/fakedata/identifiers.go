@@ -0,0 +1,214 @@
+package fakedata
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/lucapette/fakedata/pkg/data"
+)
+
+type cardNetwork struct {
+	prefixes []string
+	length   int
+}
+
+var cardNetworks = map[string]cardNetwork{
+	"amex": {prefixes: []string{"34", "37"}, length: 15},
+	"mastercard": {
+		prefixes: []string{
+			"51", "52", "53", "54", "55",
+			"2221", "2222", "2223", "2224", "2225", "2226", "2227", "2228", "2229",
+			"223", "224", "225", "226", "227", "228", "229",
+			"23", "24", "25", "26", "270", "271", "2720",
+		},
+		length: 16,
+	},
+	"visa": {prefixes: []string{"4"}, length: 16},
+}
+
+func (f factory) randomDigits(n int) string {
+	b := make([]byte, n)
+	for i := range b {
+		b[i] = byte('0' + f.rand.Intn(10))
+	}
+
+	return string(b)
+}
+
+// luhnCheckDigit returns the digit that makes payload pass the Luhn algorithm
+func luhnCheckDigit(payload string) string {
+	sum := 0
+	double := true
+	for i := len(payload) - 1; i >= 0; i-- {
+		d := int(payload[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return strconv.Itoa((10 - sum%10) % 10)
+}
+
+// gtinCheckDigit returns the check digit of EAN-13, ISBN-13 and UPC-A codes
+func gtinCheckDigit(payload string) string {
+	sum := 0
+	weight := 3
+	for i := len(payload) - 1; i >= 0; i-- {
+		sum += int(payload[i]-'0') * weight
+		weight = 4 - weight
+	}
+
+	return strconv.Itoa((10 - sum%10) % 10)
+}
+
+func isbn10CheckDigit(payload string) string {
+	sum := 0
+	for i := 0; i < len(payload); i++ {
+		sum += int(payload[i]-'0') * (10 - i)
+	}
+
+	check := (11 - sum%11) % 11
+	if check == 10 {
+		return "X"
+	}
+
+	return strconv.Itoa(check)
+}
+
+// ibanCheckDigits returns the ISO 7064 mod 97-10 check digits of an IBAN
+func ibanCheckDigits(country, bban string) string {
+	mod := 0
+	for _, r := range bban + country + "00" {
+		if r >= 'A' && r <= 'Z' {
+			mod = (mod*100 + int(r-'A'+10)) % 97
+		} else {
+			mod = (mod*10 + int(r-'0')) % 97
+		}
+	}
+
+	return fmt.Sprintf("%02d", 98-mod)
+}
+
+func (f factory) withCheckDigit(prefix string, length int, checkDigit func(string) string) func() string {
+	return func() string {
+		payload := prefix + f.randomDigits(length-1-len(prefix))
+		return payload + checkDigit(payload)
+	}
+}
+
+func (f factory) withPrefix(options string, length int, checkDigit func(string) string) (func() string, error) {
+	if strings.Trim(options, "0123456789") != "" {
+		return nil, fmt.Errorf("prefix %s must contain only digits", options)
+	}
+
+	if len(options) >= length {
+		return nil, fmt.Errorf("prefix %s must be shorter than %d digits", options, length)
+	}
+
+	return f.withCheckDigit(options, length, checkDigit), nil
+}
+
+func (f factory) creditCard(options string) (func() string, error) {
+	names := make([]string, 0, len(cardNetworks))
+	for name := range cardNetworks {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if options != "" {
+		if _, ok := cardNetworks[options]; !ok {
+			return nil, fmt.Errorf("unknown network: %s. Available networks: %s", options, strings.Join(names, "|"))
+		}
+		names = []string{options}
+	}
+
+	cards := make([][]func() string, len(names))
+	for i, name := range names {
+		network := cardNetworks[name]
+		for _, prefix := range network.prefixes {
+			cards[i] = append(cards[i], f.withCheckDigit(prefix, network.length, luhnCheckDigit))
+		}
+	}
+
+	return func() string {
+		network := cards[f.rand.Intn(len(cards))]
+		return network[f.rand.Intn(len(network))]()
+	}, nil
+}
+
+// fillPattern replaces each # in pattern with a random digit and each A with a
+// random uppercase letter. A backslash keeps the character after it as it is
+func (f factory) fillPattern(pattern string) string {
+	b := make([]byte, 0, len(pattern))
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			b = append(b, pattern[i])
+		case c == '#':
+			b = append(b, byte('0'+f.rand.Intn(10)))
+		case c == 'A':
+			b = append(b, byte('A'+f.rand.Intn(26)))
+		default:
+			b = append(b, c)
+		}
+	}
+
+	return string(b)
+}
+
+func (f factory) iban(options string) (func() string, error) {
+	countries := make([]string, 0, len(data.IBANFormats))
+	for country := range data.IBANFormats {
+		countries = append(countries, country)
+	}
+	sort.Strings(countries)
+
+	if options != "" {
+		country := strings.ToUpper(options)
+		if _, ok := data.IBANFormats[country]; !ok {
+			return nil, fmt.Errorf("unknown IBAN country: %s. Available countries: %s", options, strings.Join(countries, ","))
+		}
+		countries = []string{country}
+	}
+
+	return func() string {
+		country := countries[f.rand.Intn(len(countries))]
+
+		bban := f.fillPattern(data.IBANFormats[country])
+
+		return country + ibanCheckDigits(country, bban) + bban
+	}, nil
+}
+
+func (f factory) isbn10(options string) (func() string, error) {
+	return f.withPrefix(options, 10, isbn10CheckDigit)
+}
+
+func (f factory) isbn13(options string) (func() string, error) {
+	if options != "" {
+		return f.withPrefix(options, 13, gtinCheckDigit)
+	}
+
+	isbns := []func() string{
+		f.withCheckDigit("978", 13, gtinCheckDigit),
+		f.withCheckDigit("979", 13, gtinCheckDigit),
+	}
+
+	return func() string { return isbns[f.rand.Intn(len(isbns))]() }, nil
+}
+
+func (f factory) ean13(options string) (func() string, error) {
+	return f.withPrefix(options, 13, gtinCheckDigit)
+}
+
+func (f factory) upc(options string) (func() string, error) {
+	return f.withPrefix(options, 12, gtinCheckDigit)
+}
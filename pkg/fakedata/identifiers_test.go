@@ -0,0 +1,107 @@
+package fakedata_test
+
+import (
+	"math/big"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func luhnValid(number string) bool {
+	sum := 0
+	for i := 0; i < len(number); i++ {
+		d := int(number[len(number)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func ibanValid(iban string) bool {
+	rearranged := iban[4:] + iban[:4]
+	var digits strings.Builder
+	for _, r := range rearranged {
+		if r >= 'A' && r <= 'Z' {
+			digits.WriteString(big.NewInt(int64(r - 'A' + 10)).String())
+		} else {
+			digits.WriteRune(r)
+		}
+	}
+
+	n, ok := new(big.Int).SetString(digits.String(), 10)
+	if !ok {
+		return false
+	}
+	return new(big.Int).Mod(n, big.NewInt(97)).Int64() == 1
+}
+
+func isbn10Valid(isbn string) bool {
+	sum := 0
+	for i := 0; i < 10; i++ {
+		d := int(isbn[i] - '0')
+		if isbn[i] == 'X' {
+			d = 10
+		}
+		sum += d * (10 - i)
+	}
+	return sum%11 == 0
+}
+
+func gtinValid(code string) bool {
+	sum := 0
+	for i := 0; i < len(code); i++ {
+		d := int(code[len(code)-1-i] - '0')
+		if i%2 == 1 {
+			d *= 3
+		}
+		sum += d
+	}
+	return sum%10 == 0
+}
+
+func TestIdentifiers(t *testing.T) {
+	tests := []struct {
+		name    string
+		options string
+		pattern string
+		valid   func(string) bool
+	}{
+		{"creditcard", "", `^\d{15,16}$`, luhnValid},
+		{"creditcard", "visa", `^4\d{15}$`, luhnValid},
+		{"creditcard", "mastercard", `^(5[1-5]|2[2-7])\d{14}$`, luhnValid},
+		{"creditcard", "amex", `^3[47]\d{13}$`, luhnValid},
+		{"iban", "", `^[A-Z]{2}\d{2}[A-Z0-9]{11,30}$`, ibanValid},
+		{"iban", "DE", `^DE\d{20}$`, ibanValid},
+		{"iban", "gb", `^GB\d{2}[A-Z]{4}\d{14}$`, ibanValid},
+		{"isbn10", "", `^\d{9}[\dX]$`, isbn10Valid},
+		{"isbn13", "", `^97[89]\d{10}$`, gtinValid},
+		{"ean13", "400", `^400\d{10}$`, gtinValid},
+		{"upc", "", `^\d{12}$`, gtinValid},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name+":"+tt.options, func(t *testing.T) {
+			fn, err := gens.FindByName(tt.name).CustomFunc(tt.options)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			for i := 0; i < 1000; i++ {
+				actual := fn()
+
+				if matched, _ := regexp.MatchString(tt.pattern, actual); !matched {
+					t.Fatalf("expected %s to match '%s', but did not", actual, tt.pattern)
+				}
+
+				if !tt.valid(actual) {
+					t.Fatalf("expected %s to be valid, but was not", actual)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package fakedata
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/lucapette/fakedata/pkg/data"
+)
+
+// Locales returns the names of the available locales
+func Locales() []string {
+	names := make([]string, 0, len(data.Locales))
+	for name := range data.Locales {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+// FindLocale returns the datasets of a locale. The name is in the form
+// language_REGION (example: de_DE). An empty name returns the default English
+// data. It returns an error for unknown locales
+func FindLocale(name string) (data.Locale, error) {
+	if name == "" {
+		return data.Locale{}, nil
+	}
+
+	l, ok := data.Locales[strings.Replace(name, "-", "_", 1)]
+	if !ok {
+		return data.Locale{}, fmt.Errorf("unknown locale: %s. Available locales: %s", name, strings.Join(Locales(), ", "))
+	}
+
+	return l, nil
+}
+
+func localized(list, fallback []string) []string {
+	if len(list) == 0 {
+		return fallback
+	}
+
+	return list
+}
@@ -0,0 +1,156 @@
+package fakedata_test
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/lucapette/fakedata/pkg/data"
+	"github.com/lucapette/fakedata/pkg/fakedata"
+)
+
+func TestFindLocale(t *testing.T) {
+	tests := []struct {
+		name    string
+		locale  string
+		wantErr bool
+	}{
+		{"default", "", false},
+		{"german", "de_DE", false},
+		{"with dash", "pt-BR", false},
+		{"unknown", "xx_XX", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := fakedata.FindLocale(tt.locale); (err != nil) != tt.wantErr {
+				t.Errorf("expected (err != nil) to be %v, but got %v. err: %v", tt.wantErr, err != nil, err)
+			}
+		})
+	}
+}
+
+func TestGenerateRowWithLocale(t *testing.T) {
+	tests := []struct {
+		name     string
+		locale   string
+		input    string
+		expected []string
+	}{
+		{"german first name", "de_DE", "name.first", data.Locales["de_DE"].Firstnames},
+		{"french city", "fr_FR", "city", data.Locales["fr_FR"].Cities},
+		{"japanese street", "ja_JP", "street", data.Locales["ja_JP"].Streets},
+		{"fallback to english", "en_US", "name.last", data.Lastnames},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			locale, err := fakedata.FindLocale(tt.locale)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			columns, err := fakedata.NewLocalizedColumns([]string{tt.input}, locale)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			for index := 0; index < 1000; index++ {
+				row := bytes.Buffer{}
+				columns.GenerateRow(&row, def)
+
+				var found bool
+				for _, ex := range tt.expected {
+					if ex == strings.TrimRight(row.String(), "\n") {
+						found = true
+						break
+					}
+				}
+
+				if !found {
+					t.Fatalf("expected to find %s in %v, but did not", row.String(), tt.expected)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateRowWithLocalePhone(t *testing.T) {
+	columns, err := fakedata.NewLocalizedColumns([]string{"phone"}, data.Locales["de_DE"])
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	row := bytes.Buffer{}
+	columns.GenerateRow(&row, def)
+
+	matched, err := regexp.MatchString(`^\+49\d{9,11}$`, strings.TrimRight(row.String(), "\n"))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if !matched {
+		t.Errorf("expected %s to be a german phone number, but was not", row.String())
+	}
+}
+
+func TestGenerateRowWithFamilyNameFirst(t *testing.T) {
+	ja := data.Locales["ja_JP"]
+	columns, err := fakedata.NewLocalizedColumns([]string{"name"}, ja)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	isIn := func(list []string, s string) bool {
+		for _, l := range list {
+			if l == s {
+				return true
+			}
+		}
+		return false
+	}
+
+	for i := 0; i < 100; i++ {
+		row := bytes.Buffer{}
+		columns.GenerateRow(&row, def)
+
+		names := strings.Fields(row.String())
+		if len(names) != 2 || !isIn(ja.Lastnames, names[0]) || !isIn(ja.Firstnames, names[1]) {
+			t.Fatalf("expected the family name first, but got %s", row.String())
+		}
+	}
+}
+
+func TestLocalesDoNotLeak(t *testing.T) {
+	german, err := fakedata.NewLocalizedColumns([]string{"name.first"}, data.Locales["de_DE"])
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	english, err := fakedata.NewColumns([]string{"name.first"})
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for i := 0; i < 100; i++ {
+		for _, c := range []struct {
+			columns  fakedata.Columns
+			expected []string
+		}{{german, data.Locales["de_DE"].Firstnames}, {english, data.Firstnames}} {
+			row := bytes.Buffer{}
+			c.columns.GenerateRow(&row, def)
+
+			found := false
+			for _, name := range c.expected {
+				if name == strings.TrimSpace(row.String()) {
+					found = true
+				}
+			}
+
+			if !found {
+				t.Fatalf("expected %s to be in the dataset of its locale, but was not", row.String())
+			}
+		}
+	}
+}
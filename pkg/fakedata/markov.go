@@ -0,0 +1,112 @@
+package fakedata
+
+import (
+	"fmt"
+	"math/rand"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// markovMaxWords stops sentences of corpora that never end one
+const markovMaxWords = 100
+
+// markovChain is a word-level Markov model. It maps every sequence of order
+// words to the words that follow it in the corpus. An empty word marks the end
+// of a sentence
+type markovChain struct {
+	order  int
+	starts [][]string
+	next   map[string][]string
+}
+
+func newMarkovChain(corpus string, order int) *markovChain {
+	c := &markovChain{order: order, next: make(map[string][]string)}
+
+	for _, line := range strings.Split(corpus, "\n") {
+		var sentence []string
+		for _, word := range strings.Fields(line) {
+			sentence = append(sentence, word)
+			if strings.ContainsAny(word[len(word)-1:], ".!?") {
+				c.train(sentence)
+				sentence = nil
+			}
+		}
+		c.train(sentence)
+	}
+
+	return c
+}
+
+func (c *markovChain) train(sentence []string) {
+	if len(sentence) < c.order {
+		return
+	}
+
+	c.starts = append(c.starts, sentence[:c.order])
+
+	for i := 0; i+c.order <= len(sentence); i++ {
+		key := strings.Join(sentence[i:i+c.order], " ")
+
+		word := ""
+		if i+c.order < len(sentence) {
+			word = sentence[i+c.order]
+		}
+
+		c.next[key] = append(c.next[key], word)
+	}
+}
+
+func (c *markovChain) sentence(r *rand.Rand) string {
+	start := c.starts[r.Intn(len(c.starts))]
+	words := append([]string{}, start...)
+
+	for len(words) < markovMaxWords {
+		candidates := c.next[strings.Join(words[len(words)-c.order:], " ")]
+		word := candidates[r.Intn(len(candidates))]
+		if word == "" {
+			break
+		}
+
+		words = append(words, word)
+	}
+
+	return strings.Join(words, " ")
+}
+
+// markov generates sentences from a Markov model trained on a corpus file. The
+// options are the path and an optional order, 2 by default (example:
+// reviews.txt,3). Paths can contain commas, only an integer after the last
+// one is the order. Sentences end with a period, an exclamation mark, a
+// question mark or a new line
+func (f factory) markov(options string) (func() string, error) {
+	path, order := options, 2
+
+	if i := strings.LastIndex(options, ","); i >= 0 {
+		if o, err := strconv.Atoi(options[i+1:]); err == nil {
+			if o < 1 {
+				return nil, fmt.Errorf("order(%d) must be at least 1", o)
+			}
+
+			path, order = options[:i], o
+		}
+	}
+
+	if path == "" {
+		return nil, fmt.Errorf("no file path given")
+	}
+
+	filePath := strings.Trim(path, "'\"")
+
+	corpus, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read file %s: %v", filePath, err)
+	}
+
+	chain := newMarkovChain(string(corpus), order)
+	if len(chain.starts) == 0 {
+		return nil, fmt.Errorf("file %s has no sentence of at least %d words", filePath, order)
+	}
+
+	return func() string { return chain.sentence(f.rand) }, nil
+}
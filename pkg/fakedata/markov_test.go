@@ -0,0 +1,85 @@
+package fakedata_test
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+const corpus = "../../testutil/fixtures/corpus.txt"
+
+// ngrams returns every sequence of n consecutive words of each line
+func ngrams(lines []string, n int) map[string]bool {
+	seen := make(map[string]bool)
+	for _, line := range lines {
+		words := strings.Fields(line)
+		for i := 0; i+n <= len(words); i++ {
+			seen[strings.Join(words[i:i+n], " ")] = true
+		}
+	}
+
+	return seen
+}
+
+func TestMarkov(t *testing.T) {
+	content, err := os.ReadFile(corpus)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+	lines := strings.Split(string(content), "\n")
+
+	for _, order := range []int{1, 2, 3} {
+		t.Run(strconv.Itoa(order), func(t *testing.T) {
+			fn, err := gens.FindByName("markov").CustomFunc(corpus + "," + strconv.Itoa(order))
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			seen := ngrams(lines, order+1)
+			for i := 0; i < 1000; i++ {
+				actual := fn()
+				for gram := range ngrams([]string{actual}, order+1) {
+					if !seen[gram] {
+						t.Fatalf("expected %s to follow the corpus, but %s is not in it", actual, gram)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestMarkovWithCommaInPath(t *testing.T) {
+	content, err := os.ReadFile(corpus)
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	path := filepath.Join(t.TempDir(), "reviews,2024.txt")
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	for _, options := range []string{path, path + ",3"} {
+		fn, err := gens.FindByName("markov").CustomFunc(options)
+		if err != nil {
+			t.Fatalf("expected no error for markov:%s, but got %v", options, err)
+		}
+
+		if fn() == "" {
+			t.Errorf("expected a sentence for markov:%s, but got none", options)
+		}
+	}
+}
+
+func BenchmarkMarkov(b *testing.B) {
+	fn, err := gens.FindByName("markov").CustomFunc(corpus)
+	if err != nil {
+		b.Fatalf("cannot train on fixture: %s", err)
+	}
+
+	for i := 0; i < b.N; i++ {
+		fn()
+	}
+}
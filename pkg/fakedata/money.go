@@ -0,0 +1,102 @@
+package fakedata
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/lucapette/fakedata/pkg/data"
+)
+
+func findCurrency(code string) (data.Currency, error) {
+	for _, c := range data.Currencies {
+		if strings.EqualFold(c.Code, code) {
+			return c, nil
+		}
+	}
+
+	return data.Currency{}, fmt.Errorf("unknown currency: %s", code)
+}
+
+func (f factory) currencyCode() string {
+	return data.Currencies[f.rand.Intn(len(data.Currencies))].Code
+}
+
+// currencySymbol accepts a currency code (example: EUR)
+func (f factory) currencySymbol(options string) (func() string, error) {
+	if options == "" {
+		return func() string { return data.Currencies[f.rand.Intn(len(data.Currencies))].Symbol }, nil
+	}
+
+	c, err := findCurrency(options)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() string { return c.Symbol }, nil
+}
+
+// money generates amounts between min and max, 1 and 1000 by default, with the
+// decimals of a currency, USD by default. The options are min,max,currency, or
+// just the currency (example: 10,500,JPY)
+func (f factory) money(options string) (func() string, error) {
+	min, max, code := "1", "1000", "USD"
+
+	parts := strings.Split(options, ",")
+	switch len(parts) {
+	case 1:
+		if parts[0] != "" {
+			code = parts[0]
+		}
+	case 2:
+		min, max = parts[0], parts[1]
+	case 3:
+		min, max, code = parts[0], parts[1], parts[2]
+	default:
+		return nil, fmt.Errorf("%s must be min,max,currency (example: 10,500,JPY)", options)
+	}
+
+	c, err := findCurrency(code)
+	if err != nil {
+		return nil, err
+	}
+
+	// amounts are integers of minor units so that they are never rounded
+	scale := math.Pow10(c.MinorUnits)
+
+	low, err := strconv.ParseFloat(min, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert min: %v", err)
+	}
+
+	high, err := strconv.ParseFloat(max, 64)
+	if err != nil {
+		return nil, fmt.Errorf("could not convert max: %v", err)
+	}
+
+	if low < 0 || low > high || math.IsNaN(low) || math.IsNaN(high) {
+		return nil, fmt.Errorf("%s,%s must be a positive range", min, max)
+	}
+
+	if high*scale >= math.MaxInt64 {
+		return nil, fmt.Errorf("%s is too large for %s", max, c.Code)
+	}
+
+	// min rounds up and max rounds down so that amounts stay in the range. The
+	// tolerance absorbs the error of the multiplication (0.1*100 is not 10)
+	lowUnits, highUnits := int64(math.Ceil(low*scale-1e-6)), int64(math.Floor(high*scale+1e-6))
+	if lowUnits > highUnits {
+		return nil, fmt.Errorf("%s,%s has no amount with the %d decimals of %s", min, max, c.MinorUnits, c.Code)
+	}
+	unit := int64(scale)
+
+	return func() string {
+		amount := lowUnits + f.rand.Int63n(highUnits-lowUnits+1)
+		if c.MinorUnits == 0 {
+			return strconv.FormatInt(amount, 10)
+		}
+
+		return fmt.Sprintf("%d.%0*d", amount/unit, c.MinorUnits, amount%unit)
+	}, nil
+}
@@ -0,0 +1,29 @@
+package fakedata_test
+
+import (
+	"testing"
+
+	"github.com/lucapette/fakedata/pkg/fakedata"
+)
+
+func TestMoneyWithInvalidOptions(t *testing.T) {
+	tests := []string{
+		"money:XXX",
+		"money:20,10,USD",
+		"money:-5,10",
+		"money:ten,20",
+		"money:1,2,3,USD",
+		"money:0,1e17",
+		"money:0,100000000000000000000,JPY",
+		"money:0.001,0.004",
+		"money:0,NaN",
+	}
+
+	for _, input := range tests {
+		t.Run(input, func(t *testing.T) {
+			if _, err := fakedata.NewColumns([]string{input}); err == nil {
+				t.Errorf("expected an error for %s, but got none", input)
+			}
+		})
+	}
+}
@@ -0,0 +1,226 @@
+package fakedata
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+)
+
+func parsePrefixes(cidrs ...string) []netip.Prefix {
+	prefixes := make([]netip.Prefix, len(cidrs))
+	for i, cidr := range cidrs {
+		prefixes[i] = netip.MustParsePrefix(cidr)
+	}
+
+	return prefixes
+}
+
+var (
+	ipv4Unicast  = parsePrefixes("0.0.0.0/1", "128.0.0.0/2", "192.0.0.0/3")
+	ipv4Private  = parsePrefixes("10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16")
+	ipv4Reserved = parsePrefixes(
+		"0.0.0.0/8",
+		"100.64.0.0/10",
+		"127.0.0.0/8",
+		"169.254.0.0/16",
+		"192.0.0.0/24",
+		"192.0.2.0/24",
+		"198.18.0.0/15",
+		"198.51.100.0/24",
+		"203.0.113.0/24",
+		"224.0.0.0/4",
+		"240.0.0.0/4",
+	)
+
+	ipv6Unicast  = parsePrefixes("2000::/3")
+	ipv6Private  = parsePrefixes("fc00::/7")
+	ipv6Reserved = parsePrefixes("::1/128", "100::/64", "2001:db8::/32", "fe80::/10", "ff00::/8")
+)
+
+// randomAddr returns a random address within prefix
+func (f factory) randomAddr(prefix netip.Prefix) netip.Addr {
+	b := prefix.Masked().Addr().AsSlice()
+	bits := prefix.Bits()
+
+	for i := range b {
+		switch {
+		case 8*i >= bits:
+			b[i] = byte(f.rand.Intn(256))
+		case 8*i+8 > bits:
+			b[i] |= byte(f.rand.Intn(256)) & (0xFF >> (bits - 8*i))
+		}
+	}
+
+	addr, _ := netip.AddrFromSlice(b)
+	return addr
+}
+
+func contains(prefixes []netip.Prefix, addr netip.Addr) bool {
+	for _, p := range prefixes {
+		if p.Contains(addr) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// withPrefixes returns random addresses within prefixes that are not in
+// excluded
+func (f factory) withPrefixes(prefixes, excluded []netip.Prefix) func() netip.Addr {
+	return func() netip.Addr {
+		for {
+			addr := f.randomAddr(prefixes[f.rand.Intn(len(prefixes))])
+			if !contains(excluded, addr) {
+				return addr
+			}
+		}
+	}
+}
+
+func (f factory) ipAddress(options string, is4 bool, unicast, private, reserved []netip.Prefix) (func() netip.Addr, error) {
+	switch options {
+	case "", "public":
+		return f.withPrefixes(unicast, append(append([]netip.Prefix{}, private...), reserved...)), nil
+	case "private":
+		return f.withPrefixes(private, nil), nil
+	case "reserved":
+		return f.withPrefixes(reserved, nil), nil
+	}
+
+	prefix, err := netip.ParsePrefix(options)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse CIDR: %v", err)
+	}
+
+	if prefix.Addr().Is4() != is4 {
+		family := "IPv6"
+		if is4 {
+			family = "IPv4"
+		}
+		return nil, fmt.Errorf("%s is not an %s network", options, family)
+	}
+
+	return f.withPrefixes([]netip.Prefix{prefix}, nil), nil
+}
+
+func (f factory) ipv4(options string) (func() string, error) {
+	addr, err := f.ipAddress(options, true, ipv4Unicast, ipv4Private, ipv4Reserved)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() string { return addr().String() }, nil
+}
+
+func (f factory) ipv6(options string) (func() string, error) {
+	addr, err := f.ipAddress(options, false, ipv6Unicast, ipv6Private, ipv6Reserved)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() string { return addr().String() }, nil
+}
+
+func (f factory) cidr(options string) (func() string, error) {
+	var addr func() netip.Addr
+	var min, max int
+
+	switch options {
+	case "", "ipv4":
+		addr, _ = f.ipAddress("", true, ipv4Unicast, ipv4Private, ipv4Reserved)
+		min, max = 8, 30
+	case "ipv6":
+		addr, _ = f.ipAddress("", false, ipv6Unicast, ipv6Private, ipv6Reserved)
+		min, max = 32, 64
+	default:
+		return nil, fmt.Errorf("unknown address family: %s. Available families: ipv4|ipv6", options)
+	}
+
+	return func() string {
+		prefix, _ := addr().Prefix(min + f.rand.Intn(max+1-min))
+		return prefix.String()
+	}, nil
+}
+
+func (f factory) mac(options string) (func() string, error) {
+	var oui []byte
+	local := false
+
+	switch options {
+	case "":
+	case "local":
+		local = true
+	default:
+		b, err := hex.DecodeString(strings.NewReplacer(":", "", "-", "").Replace(options))
+		if err != nil || len(b) != 3 {
+			return nil, fmt.Errorf("OUI %s must be 3 hexadecimal bytes (example: 00:1A:2B)", options)
+		}
+		oui = b
+	}
+
+	return func() string {
+		b := make([]byte, 6)
+		for i := range b {
+			b[i] = byte(f.rand.Intn(256))
+		}
+
+		if oui != nil {
+			copy(b, oui)
+		} else {
+			// unicast, either locally or universally administered
+			b[0] &^= 0x01
+			if local {
+				b[0] |= 0x02
+			} else {
+				b[0] &^= 0x02
+			}
+		}
+
+		return fmt.Sprintf("%02X:%02X:%02X:%02X:%02X:%02X", b[0], b[1], b[2], b[3], b[4], b[5])
+	}, nil
+}
+
+func (f factory) port(options string) (func() string, error) {
+	switch options {
+	case "":
+		return f.integer("1,65535")
+	case "well-known":
+		return f.integer("1,1023")
+	case "registered":
+		return f.integer("1024,49151")
+	case "dynamic":
+		return f.integer("49152,65535")
+	}
+
+	bounds := strings.Split(options, ",")
+	if len(bounds) > 2 {
+		return nil, fmt.Errorf("%s must be either a port or min,max", options)
+	}
+
+	for _, b := range bounds {
+		n, err := strconv.Atoi(b)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert %s: %v", b, err)
+		}
+
+		if n < 1 || n > 65535 {
+			return nil, fmt.Errorf("port %d is not between 1 and 65535", n)
+		}
+	}
+
+	// a single value is a fixed port
+	if len(bounds) == 1 {
+		return func() string { return bounds[0] }, nil
+	}
+
+	return f.integer(options)
+}
+
+var hostRoles = []string{"api", "app", "cache", "db", "lb", "mail", "ns", "proxy", "web", "worker"}
+
+func (f factory) hostname() string {
+	return fmt.Sprintf("%s-%02d.%s", f.oneOf(hostRoles), 1+f.rand.Intn(99), f.domain())
+}
@@ -0,0 +1,45 @@
+package fakedata_test
+
+import (
+	"net/netip"
+	"testing"
+)
+
+func TestIPv4CoversAllOctets(t *testing.T) {
+	fn, err := gens.FindByName("ipv4").CustomFunc("10.0.0.0/8")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	seen := make(map[byte]bool)
+	for i := 0; i < 100000; i++ {
+		addr := netip.MustParseAddr(fn()).As4()
+		seen[addr[1]] = true
+	}
+
+	if !seen[0] || !seen[255] {
+		t.Errorf("expected inner octets to cover 0 and 255, but did not")
+	}
+}
+
+func TestCIDR(t *testing.T) {
+	for _, family := range []string{"ipv4", "ipv6"} {
+		t.Run(family, func(t *testing.T) {
+			fn, err := gens.FindByName("cidr").CustomFunc(family)
+			if err != nil {
+				t.Fatal(err.Error())
+			}
+
+			for i := 0; i < 1000; i++ {
+				prefix, err := netip.ParsePrefix(fn())
+				if err != nil {
+					t.Fatal(err.Error())
+				}
+
+				if prefix != prefix.Masked() {
+					t.Fatalf("expected %s to be a network address, but was not", prefix)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,114 @@
+package fakedata
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/lucapette/fakedata/pkg/data"
+)
+
+const (
+	e164          = "e164"
+	national      = "national"
+	international = "international"
+)
+
+// phonePlan returns the calling code and the numbering plan of countryCode.
+// Countries without a known plan get a generic one that keeps numbers within
+// the 15 digits E.164 allows
+func phonePlan(countryCode string) (string, data.PhonePlan) {
+	code := data.CountryCodes[countryCode]
+
+	if plan, ok := data.PhonePlans[countryCode]; ok {
+		return code, plan
+	}
+
+	// codes like 1-268 carry the area code of the country, the other countries
+	// of the North American Numbering Plan share the code 1
+	if parts := strings.SplitN(code, "-", 2); len(parts) == 2 {
+		return parts[0], data.PhonePlan{Numbers: []string{parts[1] + " N##-####"}, Parens: true}
+	}
+
+	if code == "1" {
+		return code, data.PhonePlan{Numbers: []string{"N## N##-####"}, Parens: true}
+	}
+
+	var numbers []string
+	for digits := 8; digits <= 10 && len(code)+digits <= 15; digits++ {
+		numbers = append(numbers, "N"+strings.Repeat("#", digits-1))
+	}
+
+	return code, data.PhonePlan{Numbers: numbers}
+}
+
+// numerify replaces each # in format with a random digit and each N with a
+// random digit from 2 to 9
+func (f factory) numerify(format string) string {
+	b := []byte(format)
+	for i := range b {
+		switch b[i] {
+		case '#':
+			b[i] = byte('0' + f.rand.Intn(10))
+		case 'N':
+			b[i] = byte('2' + f.rand.Intn(8))
+		}
+	}
+
+	return string(b)
+}
+
+func digitsOnly(s string) string {
+	return strings.Map(func(r rune) rune {
+		if r < '0' || r > '9' {
+			return -1
+		}
+		return r
+	}, s)
+}
+
+func (f factory) phoneNumber(countryCode, format string) func() string {
+	code, plan := phonePlan(countryCode)
+	numbers := f.withList(plan.Numbers)
+
+	return func() string {
+		number := f.numerify(numbers())
+
+		switch format {
+		case national:
+			if plan.Parens {
+				if i := strings.Index(number, " "); i > 0 {
+					return "(" + plan.Trunk + number[:i] + ")" + number[i:]
+				}
+			}
+			return plan.Trunk + number
+		case international:
+			return "+" + code + " " + number
+		default:
+			return "+" + code + digitsOnly(number)
+		}
+	}
+}
+
+func (f factory) countryPhone(countryCode string) func(string) (func() string, error) {
+	return func(format string) (func() string, error) {
+		switch format {
+		case "":
+			return f.phoneNumber(countryCode, e164), nil
+		case e164, national, international:
+			return f.phoneNumber(countryCode, format), nil
+		default:
+			return nil, fmt.Errorf("unknown phone format: %s. Available formats: e164|national|international", format)
+		}
+	}
+}
+
+func (f factory) phone(countryCodes []string) func() string {
+	phones := make([]func() string, len(countryCodes))
+	for i, k := range countryCodes {
+		phones[i] = f.phoneNumber(k, e164)
+	}
+
+	return func() string {
+		return phones[f.rand.Intn(len(phones))]()
+	}
+}
@@ -2,25 +2,50 @@ package fakedata
 
 import (
 	"bufio"
+	"context"
 	"fmt"
+	"io"
 	"math/rand"
 	"os"
+	"os/signal"
+	"path/filepath"
+	"sort"
 	"strings"
 	"text/template"
+	"text/template/parse"
 
+	"github.com/lucapette/fakedata/pkg/data"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
 )
 
 type templateFactory struct {
 	factory
+	cache map[string]func() string
+	once  map[string]string
 }
 
-func newTemplateFactory() *templateFactory {
-	return &templateFactory{factory: newFactory()}
+func newTemplateFactory(locale data.Locale, r *rand.Rand) *templateFactory {
+	return &templateFactory{
+		factory: newFactory(locale, r),
+		cache:   make(map[string]func() string),
+		once:    make(map[string]string),
+	}
+}
+
+// resetOnce forgets the values Once generated and the records of csv.row, so
+// that the next row generates new ones
+func (tf templateFactory) resetOnce() {
+	for key := range tf.once {
+		delete(tf.once, key)
+	}
+
+	tf.resetRows()
 }
 
-func (tf templateFactory) getFunctions() template.FuncMap {
+// getFunctions returns the template functions. It returns an error when a
+// generator is named like a helper, instead of hiding the helper
+func (tf templateFactory) getFunctions() (template.FuncMap, error) {
 	funcMap := template.FuncMap{
 		"Loop": func(minmax ...int) []int {
 			var n int
@@ -33,7 +58,7 @@ func (tf templateFactory) getFunctions() template.FuncMap {
 				if min == max {
 					n = min
 				} else {
-					n = rand.Intn(max-min) + min
+					n = tf.rand.Intn(max-min) + min
 				}
 			}
 
@@ -48,73 +73,342 @@ func (tf templateFactory) getFunctions() template.FuncMap {
 		"Even": func(i int) bool { return i%2 == 0 },
 	}
 
+	for _, helpers := range []template.FuncMap{stringHelpers(), mathHelpers(), dateHelpers(), listHelpers(tf.rand)} {
+		for name, fn := range helpers {
+			funcMap[name] = fn
+		}
+	}
+
+	// handler caches generators by name and options so that templates don't
+	// parse options, or read files, on every call
+	handler := func(name string, options []string) (string, error) {
+		key := name + ":" + strings.Join(options, ",")
+
+		f, ok := tf.cache[key]
+		if !ok {
+			var err error
+			f, err = tf.extractFunc(name, strings.Join(options, ","))
+			if err != nil {
+				return "", err
+			}
+
+			tf.cache[key] = f
+		}
+
+		return f(), nil
+	}
+
+	// Gen calls generators by name with the syntax of the command line
+	// (example: Gen "int:1,10"). Options can also be passed as arguments
+	// (example: Gen "int" "1,10")
+	gen := func(spec string, options ...interface{}) (string, error) {
+		specs := strings.SplitN(spec, ":", 2)
+		if len(specs) > 1 {
+			options = append([]interface{}{specs[1]}, options...)
+		}
+
+		return handler(specs[0], toOptions(options))
+	}
+	funcMap["Gen"] = gen
+
+	// Once calls generators like Gen, but only the first time it sees key in a
+	// row. Then it returns the same value, in included templates too
+	// (example: Once "customer" "name")
+	funcMap["Once"] = func(key, spec string, options ...interface{}) (string, error) {
+		if value, ok := tf.once[key]; ok {
+			return value, nil
+		}
+
+		value, err := gen(spec, options...)
+		if err != nil {
+			return "", err
+		}
+
+		tf.once[key] = value
+		return value, nil
+	}
+
 	c := cases.Title(language.English)
 
+	// every generator is a function named after it (example: mac.address
+	// becomes MacAddress). Arguments are the options of the generator, the
+	// ones the command line separates with commas
 	for _, gen := range tf.factory.generators {
+		gen := gen
+		name := strings.Replace(c.String(strings.Replace(gen.Name, ".", " ", -1)), " ", "", -1)
+		if _, ok := funcMap[name]; ok {
+			return nil, fmt.Errorf("generator %s is named like the template function %s", gen.Name, name)
+		}
+
 		if !gen.IsCustom() {
-			name := strings.Replace(c.String(strings.Replace(gen.Name, ".", " ", -1)), " ", "", -1)
 			funcMap[name] = gen.Func
+			continue
 		}
-	}
 
-	handler := func(in func(string) (func() string, error), options []string) (string, error) {
-		f, err := in(strings.Join(options, ","))
-		if err != nil {
-			return "", err
+		funcMap[name] = func(args ...interface{}) (string, error) {
+			if len(args) == 0 && gen.Func != nil {
+				return gen.Func(), nil
+			}
+
+			return handler(gen.Name, toOptions(args))
 		}
+	}
 
-		return f(), nil
+	return funcMap, nil
+}
+
+// toOptions converts template arguments so that both numbers and strings can
+// be passed to generators (example: Int 1 10)
+func toOptions(args []interface{}) []string {
+	options := make([]string, len(args))
+	for i, a := range args {
+		options[i] = fmt.Sprintf("%v", a)
+	}
+
+	return options
+}
+
+// A TemplateContext is the data of each execution of a template. Index starts
+// from 0. Total is the number of rows, 0 in stream mode where Last is never
+// true. State carries values across rows. Data is the one of TemplateOptions
+type TemplateContext struct {
+	Index int
+	Total int
+	First bool
+	Last  bool
+	State map[string]interface{}
+	Data  interface{}
+}
+
+// Set stores value in State. It returns an empty string so that templates can
+// call it without printing anything
+func (c *TemplateContext) Set(key string, value interface{}) string {
+	c.State[key] = value
+	return ""
+}
+
+// Get returns the value of key in State, nil if there is none
+func (c *TemplateContext) Get(key string) interface{} {
+	return c.State[key]
+}
+
+// A Template generates rows from a template and the templates it includes
+type Template struct {
+	t *template.Template
+}
+
+// newTemplate returns a template that knows the names of the template
+// functions. Execute replaces them with the ones of a new factory, so that
+// executions don't share generators or random sources
+func newTemplate(name string) (*Template, error) {
+	f := newTemplateFactory(data.Locale{}, newRand(0))
+	funcs, err := f.getFunctions()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Template{t: template.New(name).Funcs(funcs)}, nil
+}
+
+// ParseTemplate parses tmpl. Templates that tmpl includes by file name
+// (example: {{ template "address.tmpl" . }}) are read from dir, unless dir is
+// empty
+func ParseTemplate(tmpl, dir string) (*Template, error) {
+	t, err := newTemplate("template")
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := t.t.Parse(tmpl); err != nil {
+		return nil, err
 	}
 
-	funcMap["Int"] = func(ranges ...int) (string, error) {
-		options := make([]string, len(ranges))
-		for i, r := range ranges {
-			options[i] = fmt.Sprintf("%v", r)
+	if dir != "" {
+		if err := include(t.t, dir); err != nil {
+			return nil, err
 		}
-		return handler(integer, options)
 	}
 
-	funcMap["Enum"] = func(options ...string) (string, error) {
-		return handler(enum, options)
+	return t, nil
+}
+
+// ParseTemplateDir parses all the *.tmpl files in dir. Templates are named
+// after their file, and name selects the one that generates the rows
+func ParseTemplateDir(dir, name string) (*Template, error) {
+	t, err := newTemplate(name)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := t.t.ParseGlob(filepath.Join(dir, "*.tmpl")); err != nil {
+		return nil, err
 	}
 
-	funcMap["File"] = func(path string) (string, error) {
-		return handler(file, []string{path})
+	if err := include(t.t, dir); err != nil {
+		return nil, err
 	}
 
-	funcMap["Date"] = func(dates ...string) (string, error) {
-		return handler(date, dates)
+	if t.t.Lookup(name) == nil {
+		var names []string
+		for _, tmpl := range t.t.Templates() {
+			names = append(names, tmpl.Name())
+		}
+		sort.Strings(names)
+
+		return nil, fmt.Errorf("no template %s in %s. Available templates: %s", name, dir, strings.Join(names, ", "))
 	}
 
-	return funcMap
+	return t, nil
 }
 
-// ExecuteTemplate takes a tmpl string and a n int and generates n rows of based
-// on the specified tmpl. Will loop forever if streamMode is true
-func ExecuteTemplate(tmpl string, n int, streamMode bool) (err error) {
-	fOut := bufio.NewWriter(os.Stdout)
-	defer fOut.Flush()
+// include parses the templates t executes but doesn't define, reading them
+// from files in dir. Included templates can include others
+func include(t *template.Template, dir string) error {
+	for {
+		var missing []string
+		for _, tmpl := range t.Templates() {
+			for _, name := range includes(tmpl.Tree.Root) {
+				if t.Lookup(name) == nil {
+					missing = append(missing, name)
+				}
+			}
+		}
+
+		if len(missing) == 0 {
+			return nil
+		}
+
+		for _, name := range missing {
+			if t.Lookup(name) != nil {
+				continue
+			}
 
-	f := newTemplateFactory()
-	t, err := template.New("template").Funcs(f.getFunctions()).Parse(tmpl)
+			content, err := os.ReadFile(filepath.Join(dir, name))
+			if err != nil {
+				return fmt.Errorf("could not include %s: %v", name, err)
+			}
+
+			if _, err := t.New(name).Parse(string(content)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// includes returns the names of the templates node executes
+func includes(node parse.Node) (names []string) {
+	switch n := node.(type) {
+	case *parse.ListNode:
+		if n == nil {
+			return nil
+		}
+
+		for _, child := range n.Nodes {
+			names = append(names, includes(child)...)
+		}
+	case *parse.IfNode:
+		names = append(includes(n.List), includes(n.ElseList)...)
+	case *parse.RangeNode:
+		names = append(includes(n.List), includes(n.ElseList)...)
+	case *parse.WithNode:
+		names = append(includes(n.List), includes(n.ElseList)...)
+	case *parse.TemplateNode:
+		names = append(names, n.Name)
+	}
+
+	return names
+}
+
+// TemplateOptions configure the execution of a template
+type TemplateOptions struct {
+	// Limit is the number of rows
+	Limit int
+	// Stream generates rows until the context is done, ignoring Limit
+	Stream bool
+	// Seed, when it isn't 0, seeds the random source of the execution so that
+	// the same seed generates the same rows. Generators that depend on the
+	// current time, like the timestamps of log.apache, and UUIDs still change
+	Seed int64
+	// Data is available to templates as .Data
+	Data interface{}
+	// Locale selects the datasets of generators, see FindLocale
+	Locale data.Locale
+}
+
+// ExecuteTemplate takes a tmpl string and a n int and generates n rows of based
+// on the specified tmpl. Will loop forever if streamMode is true, until an
+// interrupt
+func ExecuteTemplate(tmpl string, n int, streamMode bool) error {
+	t, err := ParseTemplate(tmpl, "")
 	if err != nil {
 		return err
 	}
 
+	ctx := context.Background()
 	if streamMode {
-		for {
-			err = t.Execute(fOut, nil)
-			if err != nil {
-				return err
+		var stop context.CancelFunc
+		ctx, stop = signal.NotifyContext(ctx, os.Interrupt)
+		defer stop()
+	}
+
+	return t.Execute(ctx, os.Stdout, TemplateOptions{Limit: n, Stream: streamMode})
+}
+
+// Execute writes the rows of the template to w. The header and footer
+// templates, if the template defines them, are executed once before and after
+// the rows. The values of Once last one row. When ctx is done, a stream stops
+// after the current row and ends with the footer, any other execution returns
+// the error of ctx
+func (tmpl *Template) Execute(ctx context.Context, w io.Writer, opts TemplateOptions) error {
+	out := bufio.NewWriter(w)
+	defer out.Flush()
+
+	f := newTemplateFactory(opts.Locale, newRand(opts.Seed))
+	t, err := tmpl.t.Clone()
+	if err != nil {
+		return err
+	}
+	funcs, err := f.getFunctions()
+	if err != nil {
+		return err
+	}
+	t.Funcs(funcs)
+
+	row := &TemplateContext{State: make(map[string]interface{}), Data: opts.Data}
+	if !opts.Stream {
+		row.Total = opts.Limit
+	}
+
+	if t.Lookup("header") != nil {
+		if err := t.ExecuteTemplate(out, "header", row); err != nil {
+			return err
+		}
+	}
+
+rows:
+	for i := 0; opts.Stream || i < opts.Limit; i++ {
+		select {
+		case <-ctx.Done():
+			if opts.Stream {
+				break rows
 			}
+			return ctx.Err()
+		default:
+		}
+
+		row.Index, row.First = i, i == 0
+		row.Last = !opts.Stream && i == opts.Limit-1
+		f.resetOnce()
+		if err := t.Execute(out, row); err != nil {
+			return err
 		}
 	}
 
-	for i := 1; i <= n; i++ {
-		err = t.Execute(fOut, nil)
-		if err != nil {
+	if t.Lookup("footer") != nil {
+		if err := t.ExecuteTemplate(out, "footer", row); err != nil {
 			return err
 		}
 	}
-	return err
+
+	return out.Flush()
 }
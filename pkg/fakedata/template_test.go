@@ -0,0 +1,344 @@
+package fakedata_test
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/lucapette/fakedata/pkg/data"
+	"github.com/lucapette/fakedata/pkg/fakedata"
+)
+
+// executeTemplate returns one row of tmpl
+func executeTemplate(t *testing.T, tmpl string) string {
+	t.Helper()
+
+	return executeRows(t, tmpl, fakedata.TemplateOptions{Limit: 1})
+}
+
+// executeRows returns the rows of tmpl
+func executeRows(t *testing.T, tmpl string, opts fakedata.TemplateOptions) string {
+	t.Helper()
+
+	template, err := fakedata.ParseTemplate(tmpl, "")
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	var out bytes.Buffer
+	if err := template.Execute(context.Background(), &out, opts); err != nil {
+		t.Fatal(err.Error())
+	}
+
+	return out.String()
+}
+
+func TestStringHelpers(t *testing.T) {
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{"Lower", `{{ Lower "Grace HOPPER" }}`, "grace hopper"},
+		{"Upper", `{{ Upper "Grace Hopper" }}`, "GRACE HOPPER"},
+		{"Title", `{{ Title "grace hopper" }}`, "Grace Hopper"},
+		{"Slugify", `{{ Slugify "  Crème Brûlée & Co. " }}`, "creme-brulee-co"},
+		{"Trim", `{{ Trim "  grace\n" }}`, "grace"},
+		{"Replace", `{{ Replace " " "_" "grace b hopper" }}`, "grace_b_hopper"},
+		{"Substr", `{{ Substr 1 3 "Grâce" }}`, "râc"},
+		{"Substr out of range", `{{ Substr 3 10 "Grace" }}|{{ Substr 9 1 "Grace" }}`, "ce|"},
+		{"PadLeft", `{{ PadLeft 5 "0" "42" }}`, "00042"},
+		{"PadLeft longer string", `{{ PadLeft 2 "0" "4242" }}`, "4242"},
+		{"PadRight", `{{ PadRight 6 ".-" "ab" }}`, "ab.-.-"},
+		{"Split and Join", `{{ Split "," "a,b,c" | Join " | " }}`, "a | b | c"},
+		{"Split", `{{ range Split ";" "a;b" }}[{{ . }}]{{ end }}`, "[a][b]"},
+		{"EscapeJSON", `{"name": "{{ EscapeJSON "Grace \"Amazing\" <Hopper>\n" }}"}`, `{"name": "Grace \"Amazing\" <Hopper>\n"}`},
+		{"EscapeSQL", `'{{ EscapeSQL "O'Hara" }}'`, "'O''Hara'"},
+		{"EscapeCSV", `{{ EscapeCSV "plain" }},{{ EscapeCSV "a \"b\", c" }}`, `plain,"a ""b"", c"`},
+		{"pipeline", `{{ "Grace Hopper" | Replace " " "." | Lower }}`, "grace.hopper"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := executeTemplate(t, tt.tmpl); actual != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, actual)
+			}
+		})
+	}
+}
+
+func TestMathHelpers(t *testing.T) {
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{"Add", `{{ Add 1 2 }} {{ Add "40" 2 }} {{ Add 1.5 1 }}`, "3 42 2.5"},
+		{"Add decimals", `{{ Add 0.1 0.2 }} {{ Sub 0.3 0.1 }} {{ Mul 3 9.99 }}`, "0.3 0.2 29.97"},
+		{"Sub", `{{ Sub 10 (Int 3 3) }}`, "7"},
+		{"Mul", `{{ Mul 6 7 }} {{ Mul "1.5" 3 }}`, "42 4.5"},
+		{"Div", `{{ Div 7 2 }} {{ Div 7.0 2 }}`, "3 3.5"},
+		{"Mod", `{{ Mod 7 3 }}`, "1"},
+		{"Min and Max", `{{ Min 3 "5" }} {{ Max 3 "5" }} {{ Max 2.5 2 }}`, "3 5 2.5"},
+		{"comparisons", `{{ Lt 1 2 }} {{ Le 2 2 }} {{ Gt "10" 9 }} {{ Ge 1 2 }}`, "true true true false"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := executeTemplate(t, tt.tmpl); actual != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, actual)
+			}
+		})
+	}
+}
+
+func TestDateHelpers(t *testing.T) {
+	tests := []struct {
+		name string
+		tmpl string
+		want string
+	}{
+		{"ParseDate", `{{ (ParseDate "2024-02-29").Weekday }}`, "Thursday"},
+		{"FormatDate", `{{ FormatDate "02/01/2006" "2024-02-29" }} {{ FormatDate "15:04" "2024-02-29T10:30:00Z" }}`, "29/02/2024 10:30"},
+		{"DateAdd days", `{{ DateAdd "2d" "2024-02-28" | FormatDate "2006-01-02" }}`, "2024-03-01"},
+		{"DateAdd weeks", `{{ DateAdd "-1w" "2024-01-03" | FormatDate "2006-01-02" }}`, "2023-12-27"},
+		{"DateAdd hours", `{{ DateAdd "36h" "2024-01-01" | FormatDate "2006-01-02 15:04" }}`, "2024-01-02 12:00"},
+		{"Before and After", `{{ Before "2024-01-01" "2024-01-02" }} {{ After "2024-01-01" "2024-01-02" }}`, "true false"},
+		{"related dates", `{{ $order := Date "2024-01-01" "2024-01-31" }}{{ $ship := DateAdd (printf "%sd" (Int 2 10)) $order }}{{ and (After $ship $order) (Before $ship "2024-02-11") }}`, "true"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := executeTemplate(t, tt.tmpl); actual != tt.want {
+				t.Errorf("expected %s, got %s", tt.want, actual)
+			}
+		})
+	}
+}
+
+func TestListHelpers(t *testing.T) {
+	colors := []string{"red", "green", "blue", "cyan", "magenta"}
+	list := `List "red" "green" "blue" "cyan" "magenta"`
+
+	contains := func(items []string, item string) bool {
+		for _, i := range items {
+			if i == item {
+				return true
+			}
+		}
+		return false
+	}
+
+	distinct := func(t *testing.T, items []string) {
+		t.Helper()
+		seen := map[string]bool{}
+		for _, item := range items {
+			if !contains(colors, item) || seen[item] {
+				t.Errorf("expected distinct items of %v, got %v", colors, items)
+			}
+			seen[item] = true
+		}
+	}
+
+	for i := 0; i < 20; i++ {
+		if actual := executeTemplate(t, fmt.Sprintf(`{{ Pick (%s) }}`, list)); !contains(colors, actual) {
+			t.Errorf("expected one of %v, got %s", colors, actual)
+		}
+
+		picked := strings.Split(executeTemplate(t, fmt.Sprintf(`{{ %s | PickN 3 | Join "," }}`, list)), ",")
+		if len(picked) != 3 {
+			t.Errorf("expected 3 items, got %v", picked)
+		}
+		distinct(t, picked)
+
+		shuffled := strings.Split(executeTemplate(t, fmt.Sprintf(`{{ %s | Shuffle | Join "," }}`, list)), ",")
+		if len(shuffled) != len(colors) {
+			t.Errorf("expected %d items, got %v", len(colors), shuffled)
+		}
+		distinct(t, shuffled)
+
+		sampled := strings.Split(executeTemplate(t, fmt.Sprintf(`{{ %s | Sample (Int 2 2) | Join "," }}`, list)), ",")
+		if len(sampled) != 2 {
+			t.Errorf("expected 2 items, got %v", sampled)
+		}
+		distinct(t, sampled)
+
+		if strings.Index(strings.Join(colors, ","), sampled[0]) > strings.Index(strings.Join(colors, ","), sampled[1]) {
+			t.Errorf("expected Sample to keep the order of the list, got %v", sampled)
+		}
+	}
+}
+
+func TestHelpersWithInvalidArguments(t *testing.T) {
+	for _, tmpl := range []string{
+		`{{ Add "one" 2 }}`,
+		`{{ Div 1 0 }}`,
+		`{{ Mod 1.5 2 }}`,
+		`{{ Gt "many" 1 }}`,
+		`{{ ParseDate "yesterday" }}`,
+		`{{ DateAdd "soon" "2024-01-01" }}`,
+		`{{ DateAdd "xd" "2024-01-01" }}`,
+		`{{ Pick (List) }}`,
+		`{{ PickN 2 (List "a") }}`,
+		`{{ Sample "some" (List "a") }}`,
+	} {
+		t.Run(tmpl, func(t *testing.T) {
+			if err := fakedata.ExecuteTemplate(tmpl, 1, false); err == nil {
+				t.Errorf("expected an error for %s, but got none", tmpl)
+			}
+		})
+	}
+}
+
+func TestOnce(t *testing.T) {
+	tmpl := `{{ define "greeting" }}Hi {{ Once "n" "int:1,1000000000" }}{{ end -}}
+{{ Once "n" "int" 1 1000000000 }} {{ Once "n" "int:1,1000000000" }} {{ template "greeting" }}
+`
+	rows := strings.Split(strings.TrimSpace(executeRows(t, tmpl, fakedata.TemplateOptions{Limit: 3})), "\n")
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 rows, got %d", len(rows))
+	}
+
+	seen := map[string]bool{}
+	for _, row := range rows {
+		var first, second, third string
+		if _, err := fmt.Sscanf(row, "%s %s Hi %s", &first, &second, &third); err != nil {
+			t.Fatalf("could not scan %s: %v", row, err)
+		}
+
+		if first != second || first != third {
+			t.Errorf("expected the same value in a row, got %s", row)
+		}
+
+		if seen[first] {
+			t.Errorf("expected a new value for each row, got %s twice", first)
+		}
+		seen[first] = true
+	}
+}
+
+func TestOnceWithUnknownGenerator(t *testing.T) {
+	if err := fakedata.ExecuteTemplate(`{{ Once "n" "madeupgenerator" }}`, 1, false); err == nil {
+		t.Error("expected an error for an unknown generator, but got none")
+	}
+}
+
+func TestTemplateExecute(t *testing.T) {
+	tmpl := `{{ define "header" }}[{{ .Data }}]
+{{ end }}{{ define "footer" }}[{{ .Index }}]
+{{ end }}{{ .Index }}/{{ .Total }} {{ Int 1 1000000 }} {{ Name }}
+`
+
+	t.Run("limit and data", func(t *testing.T) {
+		rows := strings.Split(executeRows(t, tmpl, fakedata.TemplateOptions{Limit: 3, Data: "users"}), "\n")
+		if len(rows) != 6 || rows[0] != "[users]" || !strings.HasPrefix(rows[3], "2/3 ") || rows[4] != "[2]" {
+			t.Errorf("expected a header, 3 rows and a footer, got %q", rows)
+		}
+	})
+
+	t.Run("locale", func(t *testing.T) {
+		german := data.Locales["de_DE"]
+		actual := executeRows(t, "{{ NameFirst }}", fakedata.TemplateOptions{Limit: 1, Locale: german})
+
+		found := false
+		for _, name := range german.Firstnames {
+			if name == actual {
+				found = true
+			}
+		}
+
+		if !found {
+			t.Errorf("expected %s to be a German first name, but was not", actual)
+		}
+	})
+
+	t.Run("seed", func(t *testing.T) {
+		first := executeRows(t, tmpl, fakedata.TemplateOptions{Limit: 3, Seed: 42})
+		second := executeRows(t, tmpl, fakedata.TemplateOptions{Limit: 3, Seed: 42})
+		if first != second {
+			t.Errorf("expected the same rows for the same seed, got %s and %s", first, second)
+		}
+
+		if other := executeRows(t, tmpl, fakedata.TemplateOptions{Limit: 3, Seed: 7}); other == first {
+			t.Errorf("expected different rows for a different seed, got %s twice", first)
+		}
+	})
+
+	t.Run("seed with generators that keep state", func(t *testing.T) {
+		// log lines draw their clients when the generator is built, and their
+		// timestamps depend on the current time
+		timestamps := regexp.MustCompile(`\[[^]]*\]`)
+		tmpl := `{{ LogApache }} {{ List "a" "b" "c" | Shuffle | Join "," }}
+`
+
+		first := executeRows(t, tmpl, fakedata.TemplateOptions{Limit: 5, Seed: 42})
+		second := executeRows(t, tmpl, fakedata.TemplateOptions{Limit: 5, Seed: 42})
+		if timestamps.ReplaceAllString(first, "") != timestamps.ReplaceAllString(second, "") {
+			t.Errorf("expected the same rows for the same seed, got %s and %s", first, second)
+		}
+	})
+
+	t.Run("stream until cancel", func(t *testing.T) {
+		template, err := fakedata.ParseTemplate(tmpl, "")
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		var out bytes.Buffer
+		if err := template.Execute(ctx, &out, fakedata.TemplateOptions{Stream: true}); err != nil {
+			t.Fatal(err.Error())
+		}
+
+		rows := strings.Split(strings.TrimSpace(out.String()), "\n")
+		if len(rows) < 3 || !strings.HasPrefix(rows[len(rows)-1], "[") {
+			t.Errorf("expected a header, rows and a footer, got %q", rows)
+		}
+	})
+
+	t.Run("cancel", func(t *testing.T) {
+		template, err := fakedata.ParseTemplate(tmpl, "")
+		if err != nil {
+			t.Fatal(err.Error())
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		if err := template.Execute(ctx, io.Discard, fakedata.TemplateOptions{Limit: 10}); err != context.Canceled {
+			t.Errorf("expected %v, got %v", context.Canceled, err)
+		}
+	})
+}
+
+func BenchmarkExecuteTemplate(b *testing.B) {
+	tests := []struct {
+		name string
+		tmpl string
+	}{
+		{"File", `{{ File "../../testutil/fixtures/file.txt" }} {{ File "../../testutil/fixtures/file.txt" }}`},
+		{"Int", `{{ Int 1 100 }} {{ Int 1 100 }}`},
+		{"Date", `{{ Date "2020-01-01" "2020-12-31" }}`},
+		{"Enum", `{{ Enum "foo" "bar" "baz" }}`},
+	}
+
+	for _, tt := range tests {
+		b.Run(tt.name, func(b *testing.B) {
+			tmpl, err := fakedata.ParseTemplate(tt.tmpl+"\n", "")
+			if err != nil {
+				b.Fatal(err.Error())
+			}
+
+			if err := tmpl.Execute(context.Background(), io.Discard, fakedata.TemplateOptions{Limit: b.N}); err != nil {
+				b.Fatal(err.Error())
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package fakedata
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/lucapette/fakedata/pkg/data"
+)
+
+var vocabulary = append(append([]string{}, data.Adjectives...), data.Nouns...)
+
+// withLorem strips an optional trailing lorem from options. It returns the
+// remaining options and whether lorem ipsum was requested
+func withLorem(options string) (string, bool) {
+	switch {
+	case options == "lorem":
+		return "", true
+	case strings.HasSuffix(options, ",lorem"):
+		return strings.TrimSuffix(options, ",lorem"), true
+	}
+
+	return options, false
+}
+
+func nWords(n int, word func() string) string {
+	w := make([]string, n)
+	for i := range w {
+		w[i] = word()
+	}
+
+	return strings.Join(w, " ")
+}
+
+func (f factory) loremSentence() string {
+	s := nWords(6+f.rand.Intn(7), f.withList(data.Lorem))
+	return strings.ToUpper(s[:1]) + s[1:] + "."
+}
+
+// truncate shortens s to at most max bytes without cutting words in half
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+
+	cut := strings.LastIndex(s[:max+1], " ")
+	if cut <= 0 {
+		return s[:max]
+	}
+
+	return strings.TrimRight(s[:cut], ",;:")
+}
+
+// words generates n words (example: words:5) or between min and max words
+// (example: words:3,8)
+func (f factory) words(options string) (func() string, error) {
+	options, lorem := withLorem(options)
+
+	count, err := f.withCount(options, 3, 8)
+	if err != nil {
+		return nil, err
+	}
+
+	word := f.withList(vocabulary)
+	if lorem {
+		word = f.withList(data.Lorem)
+	}
+
+	return func() string { return nWords(count(), word) }, nil
+}
+
+// paragraph generates paragraphs of n sentences (example: paragraph:4) or
+// between min and max sentences (example: paragraph:2,5)
+func (f factory) paragraph(options string) (func() string, error) {
+	options, lorem := withLorem(options)
+
+	count, err := f.withCount(options, 3, 6)
+	if err != nil {
+		return nil, err
+	}
+
+	sentence := f.withList(data.Sentences)
+	if lorem {
+		sentence = f.loremSentence
+	}
+
+	return func() string { return nWords(count(), sentence) }, nil
+}
+
+// text generates sentences up to maxchars characters, 200 by default
+func (f factory) text(options string) (func() string, error) {
+	options, lorem := withLorem(options)
+
+	max := 200
+	if options != "" {
+		m, err := strconv.Atoi(options)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert %s: %v", options, err)
+		}
+
+		if m <= 0 {
+			return nil, fmt.Errorf("%d must be positive", m)
+		}
+
+		max = m
+	}
+
+	sentence := f.withList(data.Sentences)
+	if lorem {
+		sentence = f.loremSentence
+	}
+
+	return func() string {
+		var b strings.Builder
+		for b.Len() < max {
+			if b.Len() > 0 {
+				b.WriteString(" ")
+			}
+			b.WriteString(sentence())
+		}
+
+		return truncate(b.String(), max)
+	}, nil
+}
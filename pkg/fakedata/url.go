@@ -0,0 +1,152 @@
+package fakedata
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/lucapette/fakedata/pkg/data"
+)
+
+var urlPorts = []string{"3000", "8000", "8080", "8443", "9000"}
+
+// slug returns a lowercase noun without characters that need escaping in URLs
+func (f factory) slug() string {
+	return strings.Map(func(r rune) rune {
+		if (r < 'a' || r > 'z') && r != '-' {
+			return -1
+		}
+		return r
+	}, strings.ToLower(f.oneOf(data.Nouns)))
+}
+
+// withCount returns a func that generates the number of path segments, query
+// parameters and the like. It returns n when options is an integer, a random
+// number between min and max otherwise. Options can also override min and max
+// (example: 2,5)
+func (f factory) withCount(options string, min, max int) (func() int, error) {
+	if options == "" {
+		return func() int { return min + f.rand.Intn(max+1-min) }, nil
+	}
+
+	bounds := strings.Split(options, ",")
+	if len(bounds) > 2 {
+		return nil, fmt.Errorf("%s must be either n or min,max", options)
+	}
+
+	counts := make([]int, len(bounds))
+	for i, b := range bounds {
+		n, err := strconv.Atoi(b)
+		if err != nil {
+			return nil, fmt.Errorf("could not convert %s: %v", b, err)
+		}
+
+		if n < 0 {
+			return nil, fmt.Errorf("%d must be positive", n)
+		}
+
+		counts[i] = n
+	}
+
+	if len(counts) == 1 {
+		return func() int { return counts[0] }, nil
+	}
+
+	if counts[0] > counts[1] {
+		return nil, fmt.Errorf("max(%d) is smaller than min(%d)", counts[1], counts[0])
+	}
+
+	return f.withCount("", counts[0], counts[1])
+}
+
+func (f factory) urlPath(options string) (func() string, error) {
+	depth, err := f.withCount(options, 0, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() string {
+		n := depth()
+		segments := make([]string, n)
+		for i := 0; i < n; i++ {
+			segments[i] = f.slug()
+		}
+
+		return "/" + strings.Join(segments, "/")
+	}, nil
+}
+
+func (f factory) urlQuery(options string) (func() string, error) {
+	params, err := f.withCount(options, 1, 3)
+	if err != nil {
+		return nil, err
+	}
+
+	return func() string {
+		n := params()
+		values := url.Values{}
+		for len(values) < n {
+			// there are fewer slugs than the parameters one can ask for, a
+			// numeric suffix keeps keys unique
+			base := f.slug()
+			key := base
+			for i := 2; values.Has(key); i++ {
+				key = fmt.Sprintf("%s%d", base, i)
+			}
+
+			if f.rand.Intn(2) == 0 {
+				values.Set(key, strconv.Itoa(f.rand.Intn(1000)))
+			} else {
+				values.Set(key, f.slug())
+			}
+		}
+
+		return values.Encode()
+	}, nil
+}
+
+// webURL generates URLs. The options are a scheme and a path depth separated by
+// a comma, both optional (example: https,2)
+func (f factory) webURL(options string) (func() string, error) {
+	var scheme, depth string
+
+	parts := strings.Split(options, ",")
+	scheme = parts[0]
+	if len(parts) > 1 {
+		depth = parts[1]
+	}
+
+	schemes := f.withList([]string{"http", "https"})
+	if scheme != "" {
+		if strings.Trim(strings.ToLower(scheme), "abcdefghijklmnopqrstuvwxyz") != "" {
+			return nil, fmt.Errorf("scheme %s must contain only letters", scheme)
+		}
+		schemes = func() string { return scheme }
+	}
+
+	path, err := f.urlPath(depth)
+	if err != nil {
+		return nil, err
+	}
+
+	query, _ := f.urlQuery("")
+
+	return func() string {
+		u := url.URL{Scheme: schemes(), Host: f.domain(), Path: path()}
+
+		if f.rand.Intn(10) == 0 {
+			u.Host += ":" + f.oneOf(urlPorts)
+		}
+
+		if f.rand.Intn(2) == 0 {
+			u.RawQuery = query()
+		}
+
+		if f.rand.Intn(5) == 0 {
+			u.Fragment = f.slug()
+		}
+
+		return u.String()
+	}, nil
+}
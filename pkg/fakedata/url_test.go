@@ -0,0 +1,26 @@
+package fakedata_test
+
+import (
+	"net/url"
+	"strconv"
+	"testing"
+
+	"github.com/lucapette/fakedata/pkg/data"
+)
+
+func TestURLQueryWithMoreParametersThanNouns(t *testing.T) {
+	n := len(data.Nouns) + 10
+	fn, err := gens.FindByName("url.query").CustomFunc(strconv.Itoa(n))
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	values, err := url.ParseQuery(fn())
+	if err != nil {
+		t.Fatal(err.Error())
+	}
+
+	if len(values) != n {
+		t.Fatalf("expected %d parameters, but got %d", n, len(values))
+	}
+}